@@ -1,3 +1,7 @@
 data "unstructured_destination" "example" {
   id = "3778abff-ac39-4080-8f2c-06ec860fb492"
-}
\ No newline at end of file
+}
+
+data "unstructured_destination" "by_name" {
+  name = "prod-destination"
+}
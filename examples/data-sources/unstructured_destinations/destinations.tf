@@ -0,0 +1,3 @@
+data "unstructured_destinations" "example" {
+  type = "pinecone"
+}
@@ -1,3 +1,7 @@
 data "unstructured_source" "example" {
   id = "5bbd51a7-3021-4f05-b6b3-f6b8668f72cd"
-}
\ No newline at end of file
+}
+
+data "unstructured_source" "by_name" {
+  name = "prod-sharepoint-hr"
+}
@@ -0,0 +1,8 @@
+data "unstructured_sources" "example" {
+  type       = "s3"
+  name_regex = "^prod-"
+}
+
+output "source_ids" {
+  value = data.unstructured_sources.example.sources[*].id
+}
@@ -1,3 +1,7 @@
 data "unstructured_workflow" "example" {
   id = "382c063b-00f9-484c-a41b-0b262b061386"
 }
+
+data "unstructured_workflow" "by_name" {
+  name = "prod-workflow"
+}
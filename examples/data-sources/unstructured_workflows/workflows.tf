@@ -0,0 +1,4 @@
+data "unstructured_workflows" "example" {
+  status    = "active"
+  source_id = "2a3c6bd3-4b27-4f3a-a6b1-6fe3b6f6e2c5"
+}
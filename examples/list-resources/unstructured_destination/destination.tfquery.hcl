@@ -0,0 +1,8 @@
+list "unstructured_destination" "example" {
+  provider         = unstructured
+  include_resource = true
+
+  config {
+    type = "pinecone"
+  }
+}
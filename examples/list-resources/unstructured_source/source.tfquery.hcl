@@ -0,0 +1,8 @@
+list "unstructured_source" "example" {
+  provider = unstructured
+
+  config {
+    type       = "s3"
+    name_regex = "^prod-"
+  }
+}
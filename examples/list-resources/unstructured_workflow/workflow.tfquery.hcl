@@ -0,0 +1,9 @@
+list "unstructured_workflow" "example" {
+  provider = unstructured
+  limit    = 50
+
+  config {
+    status     = "active"
+    name_regex = "-ingest$"
+  }
+}
@@ -2,6 +2,10 @@ provider "unstructured" {
   # API key should be set via environment variable UNSTRUCTURED_API_KEY
   # api_key = "your-api-key-here"
 
+  # Alternatively, read the API key and endpoint from a named profile in
+  # ~/.config/unstructured/credentials, or set UNSTRUCTURED_PROFILE
+  # profile = "prod"
+
   # Endpoint can be overridden if needed, defaults to https://platform.unstructuredapp.io/api/v1
   # endpoint = "https://platform.unstructuredapp.io/api/v1"
 }
@@ -0,0 +1,6 @@
+import {
+  to = unstructured_destination.example
+  identity = {
+    id = "bc15ad07-a46e-4cc4-893a-8173047b7165"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = unstructured_source.example
+  identity = {
+    id = "aa00dd4a-be62-47ff-a757-aada4843a3ce"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = unstructured_workflow.example
+  identity = {
+    id = "ede1ad4e-b751-4565-90d3-c238c7a3ace5"
+  }
+}
@@ -0,0 +1,89 @@
+package convert
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/aws-gopher/unstructured-sdk-go"
+)
+
+// Source returns the canonical form of source, with its connector config
+// under the name of its connector block. It reports false if the connector
+// type is not supported or does not match the config, in which case the
+// config is under config_json as JSON instead.
+func Source(source *unstructured.Source) (Object, bool) {
+	obj := Object{
+		"id":         source.ID,
+		"name":       source.Name,
+		"type":       source.Type,
+		"created_at": source.CreatedAt.Format(time.RFC3339),
+		"updated_at": source.UpdatedAt.Format(time.RFC3339),
+	}
+
+	// A config of another connector type than the source is not trusted
+	block, config, ok := sourceConnector(source.Config)
+	if ok && sourceConnectorTypes[block] != source.Type {
+		ok = false
+	}
+
+	if ok {
+		obj[block] = config
+	} else {
+		obj["config_json"] = configJSON(source.Config)
+	}
+
+	return obj, ok
+}
+
+// Destination returns the canonical form of destination, with its connector
+// config under the name of its connector block. It reports false if the
+// connector type is not supported or does not match the config, in which
+// case the config is under config_json as JSON instead.
+func Destination(destination *unstructured.Destination) (Object, bool) {
+	obj := Object{
+		"id":         destination.ID,
+		"name":       destination.Name,
+		"type":       destination.Type,
+		"created_at": destination.CreatedAt.Format(time.RFC3339),
+		"updated_at": destination.UpdatedAt.Format(time.RFC3339),
+	}
+
+	// A config of another connector type than the destination is not trusted
+	block, config, ok := destinationConnector(destination.Config)
+	if ok && destinationConnectorTypes[block] != destination.Type {
+		ok = false
+	}
+
+	if ok {
+		obj[block] = config
+	} else {
+		obj["config_json"] = configJSON(destination.Config)
+	}
+
+	return obj, ok
+}
+
+// SourceConnectorType returns the API connector type of the sources that
+// have the given connector block, or "" if there is no such block.
+func SourceConnectorType(block string) string {
+	return sourceConnectorTypes[block]
+}
+
+// DestinationConnectorType returns the API connector type of the
+// destinations that have the given connector block, or "" if there is no such
+// block.
+func DestinationConnectorType(block string) string {
+	return destinationConnectorTypes[block]
+}
+
+// configJSON returns config encoded as JSON, or nil if it cannot be encoded.
+func configJSON(config any) *string {
+	b, err := json.Marshal(config)
+	if err != nil {
+		return nil
+	}
+
+	s := string(b)
+
+	return &s
+}
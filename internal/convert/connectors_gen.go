@@ -0,0 +1,461 @@
+// Code generated by genconverters. DO NOT EDIT.
+
+package convert
+
+import "github.com/aws-gopher/unstructured-sdk-go"
+
+// sourceConnector returns the connector block name and canonical form of config,
+// or false if config is not a supported connector.
+func sourceConnector(config unstructured.SourceConfig) (string, Object, bool) {
+	switch config := config.(type) {
+	case *unstructured.S3SourceConnectorConfig:
+		return "s3", Object{
+			"remote_url":   config.RemoteURL,
+			"anonymous":    config.Anonymous,
+			"key":          config.Key,
+			"secret":       config.Secret,
+			"token":        config.Token,
+			"endpoint_url": config.EndpointURL,
+			"recursive":    config.Recursive,
+		}, true
+	case *unstructured.PostgresSourceConnectorConfig:
+		return "postgres", Object{
+			"host":       config.Host,
+			"database":   config.Database,
+			"port":       config.Port,
+			"username":   config.Username,
+			"password":   config.Password,
+			"table_name": config.TableName,
+			"batch_size": config.BatchSize,
+			"id_column":  config.IDColumn,
+			"fields":     config.Fields,
+		}, true
+	case *unstructured.AzureSourceConnectorConfig:
+		return "azure", Object{
+			"remote_url":        config.RemoteURL,
+			"account_name":      config.AccountName,
+			"account_key":       config.AccountKey,
+			"connection_string": config.ConnectionString,
+			"sas_token":         config.SASToken,
+			"recursive":         config.Recursive,
+		}, true
+	case *unstructured.BoxSourceConnectorConfig:
+		return "box", Object{
+			"box_app_config": config.BoxAppConfig,
+			"recursive":      config.Recursive,
+		}, true
+	case *unstructured.ConfluenceSourceConnectorConfig:
+		return "confluence", Object{
+			"url":                             config.URL,
+			"username":                        config.Username,
+			"password":                        config.Password,
+			"api_token":                       config.APIToken,
+			"token":                           config.Token,
+			"cloud":                           config.Cloud,
+			"extract_images":                  config.ExtractImages,
+			"extract_files":                   config.ExtractFiles,
+			"max_num_of_spaces":               config.MaxNumOfSpaces,
+			"max_num_of_docs_from_each_space": config.MaxNumOfDocsFromEachSpace,
+			"spaces":                          config.Spaces,
+		}, true
+	case *unstructured.CouchbaseSourceConnectorConfig:
+		return "couchbase", Object{
+			"bucket":            config.Bucket,
+			"connection_string": config.ConnectionString,
+			"scope":             config.Scope,
+			"collection":        config.Collection,
+			"batch_size":        config.BatchSize,
+			"username":          config.Username,
+			"password":          config.Password,
+			"collection_id":     config.CollectionID,
+		}, true
+	case *unstructured.DatabricksVolumesConnectorConfig:
+		return "databricks_volumes", Object{
+			"host":          config.Host,
+			"catalog":       config.Catalog,
+			"schema":        config.Schema,
+			"volume":        config.Volume,
+			"volume_path":   config.VolumePath,
+			"client_secret": config.ClientSecret,
+			"client_id":     config.ClientID,
+		}, true
+	case *unstructured.DropboxSourceConnectorConfig:
+		return "dropbox", Object{
+			"token":      config.Token,
+			"remote_url": config.RemoteURL,
+			"recursive":  config.Recursive,
+		}, true
+	case *unstructured.ElasticsearchConnectorConfig:
+		return "elasticsearch", Object{
+			"hosts":      config.Hosts,
+			"index_name": config.IndexName,
+			"es_api_key": config.ESAPIKey,
+		}, true
+	case *unstructured.GCSSourceConnectorConfig:
+		return "gcs", Object{
+			"remote_url":          config.RemoteURL,
+			"service_account_key": config.ServiceAccountKey,
+			"recursive":           config.Recursive,
+		}, true
+	case *unstructured.GoogleDriveSourceConnectorConfig:
+		return "google_drive", Object{
+			"drive_id":            config.DriveID,
+			"service_account_key": config.ServiceAccountKey,
+			"extensions":          config.Extensions,
+			"recursive":           config.Recursive,
+		}, true
+	case *unstructured.JiraSourceConnectorConfig:
+		return "jira", Object{
+			"url":                  config.URL,
+			"username":             config.Username,
+			"password":             config.Password,
+			"token":                config.Token,
+			"cloud":                config.Cloud,
+			"projects":             config.Projects,
+			"boards":               config.Boards,
+			"issues":               config.Issues,
+			"status_filters":       config.StatusFilters,
+			"download_attachments": config.DownloadAttachments,
+		}, true
+	case *unstructured.KafkaCloudSourceConnectorConfig:
+		return "kafka_cloud", Object{
+			"bootstrap_servers":       config.BootstrapServers,
+			"port":                    config.Port,
+			"group_id":                config.GroupID,
+			"topic":                   config.Topic,
+			"kafka_api_key":           config.KafkaAPIKey,
+			"secret":                  config.Secret,
+			"num_messages_to_consume": config.NumMessagesToConsume,
+		}, true
+	case *unstructured.MongoDBConnectorConfig:
+		return "mongodb", Object{
+			"database":   config.Database,
+			"collection": config.Collection,
+			"uri":        config.URI,
+		}, true
+	case *unstructured.OneDriveSourceConnectorConfig:
+		return "onedrive", Object{
+			"client_id":     config.ClientID,
+			"user_pname":    config.UserPName,
+			"tenant":        config.Tenant,
+			"authority_url": config.AuthorityURL,
+			"client_cred":   config.ClientCred,
+			"recursive":     config.Recursive,
+			"path":          config.Path,
+		}, true
+	case *unstructured.OutlookSourceConnectorConfig:
+		return "outlook", Object{
+			"authority_url":   config.AuthorityURL,
+			"tenant":          config.Tenant,
+			"client_id":       config.ClientID,
+			"client_cred":     config.ClientCred,
+			"outlook_folders": config.OutlookFolders,
+			"recursive":       config.Recursive,
+			"user_email":      config.UserEmail,
+		}, true
+	case *unstructured.SalesforceSourceConnectorConfig:
+		return "salesforce", Object{
+			"username":     config.Username,
+			"consumer_key": config.ConsumerKey,
+			"private_key":  config.PrivateKey,
+			"categories":   config.Categories,
+		}, true
+	case *unstructured.SharePointSourceConnectorConfig:
+		return "sharepoint", Object{
+			"site":          config.Site,
+			"tenant":        config.Tenant,
+			"authority_url": config.AuthorityURL,
+			"user_pname":    config.UserPName,
+			"client_id":     config.ClientID,
+			"client_cred":   config.ClientCred,
+			"recursive":     config.Recursive,
+			"path":          config.Path,
+		}, true
+	case *unstructured.SnowflakeSourceConnectorConfig:
+		return "snowflake", Object{
+			"account":    config.Account,
+			"role":       config.Role,
+			"user":       config.User,
+			"password":   config.Password,
+			"host":       config.Host,
+			"port":       config.Port,
+			"database":   config.Database,
+			"schema":     config.Schema,
+			"table_name": config.TableName,
+			"batch_size": config.BatchSize,
+			"id_column":  config.IDColumn,
+			"fields":     config.Fields,
+		}, true
+	case *unstructured.ZendeskSourceConnectorConfig:
+		return "zendesk", Object{
+			"subdomain":  config.Subdomain,
+			"email":      config.Email,
+			"api_token":  config.APIToken,
+			"item_type":  config.ItemType,
+			"batch_size": config.BatchSize,
+		}, true
+	}
+
+	return "", nil, false
+}
+
+// sourceConnectorTypes maps connector block names to their API connector types.
+var sourceConnectorTypes = map[string]string{
+	"s3":                 unstructured.S3SourceConnectorConfigInput{}.Type(),
+	"postgres":           unstructured.PostgresSourceConnectorConfigInput{}.Type(),
+	"azure":              unstructured.AzureSourceConnectorConfigInput{}.Type(),
+	"box":                unstructured.BoxSourceConnectorConfigInput{}.Type(),
+	"confluence":         unstructured.ConfluenceSourceConnectorConfigInput{}.Type(),
+	"couchbase":          unstructured.CouchbaseSourceConnectorConfigInput{}.Type(),
+	"databricks_volumes": unstructured.DatabricksVolumesConnectorConfigInput{}.Type(),
+	"dropbox":            unstructured.DropboxSourceConnectorConfigInput{}.Type(),
+	"elasticsearch":      unstructured.ElasticsearchConnectorConfigInput{}.Type(),
+	"gcs":                unstructured.GCSSourceConnectorConfigInput{}.Type(),
+	"google_drive":       unstructured.GoogleDriveSourceConnectorConfigInput{}.Type(),
+	"jira":               unstructured.JiraSourceConnectorConfigInput{}.Type(),
+	"kafka_cloud":        unstructured.KafkaCloudSourceConnectorConfigInput{}.Type(),
+	"mongodb":            unstructured.MongoDBConnectorConfigInput{}.Type(),
+	"onedrive":           unstructured.OneDriveSourceConnectorConfigInput{}.Type(),
+	"outlook":            unstructured.OutlookSourceConnectorConfigInput{}.Type(),
+	"salesforce":         unstructured.SalesforceSourceConnectorConfigInput{}.Type(),
+	"sharepoint":         unstructured.SharePointSourceConnectorConfigInput{}.Type(),
+	"snowflake":          unstructured.SnowflakeSourceConnectorConfigInput{}.Type(),
+	"zendesk":            unstructured.ZendeskSourceConnectorConfigInput{}.Type(),
+}
+
+// destinationConnector returns the connector block name and canonical form of config,
+// or false if config is not a supported connector.
+func destinationConnector(config unstructured.DestinationConfig) (string, Object, bool) {
+	switch config := config.(type) {
+	case *unstructured.AstraDBConnectorConfig:
+		return "astradb", Object{
+			"collection_name": config.CollectionName,
+			"keyspace":        config.Keyspace,
+			"batch_size":      config.BatchSize,
+			"api_endpoint":    config.APIEndpoint,
+			"token":           config.Token,
+		}, true
+	case *unstructured.AzureAISearchConnectorConfig:
+		return "azure_ai_search", Object{
+			"endpoint": config.Endpoint,
+			"index":    config.Index,
+			"key":      config.Key,
+		}, true
+	case *unstructured.CouchbaseDestinationConnectorConfig:
+		return "couchbase", Object{
+			"bucket":            config.Bucket,
+			"connection_string": config.ConnectionString,
+			"scope":             config.Scope,
+			"collection":        config.Collection,
+			"batch_size":        config.BatchSize,
+			"username":          config.Username,
+			"password":          config.Password,
+		}, true
+	case *unstructured.DatabricksVolumesConnectorConfig:
+		return "databricks_volumes", Object{
+			"host":          config.Host,
+			"catalog":       config.Catalog,
+			"schema":        config.Schema,
+			"volume":        config.Volume,
+			"volume_path":   config.VolumePath,
+			"client_secret": config.ClientSecret,
+			"client_id":     config.ClientID,
+		}, true
+	case *unstructured.DatabricksVDTDestinationConnectorConfig:
+		return "databricks_volume_delta_tables", Object{
+			"server_hostname": config.ServerHostname,
+			"http_path":       config.HTTPPath,
+			"token":           config.Token,
+			"client_id":       config.ClientID,
+			"client_secret":   config.ClientSecret,
+			"catalog":         config.Catalog,
+			"database":        config.Database,
+			"table_name":      config.TableName,
+			"schema":          config.Schema,
+			"volume":          config.Volume,
+			"volume_path":     config.VolumePath,
+		}, true
+	case *unstructured.DeltaTableConnectorConfig:
+		return "delta_table", Object{
+			"aws_access_key_id":     config.AwsAccessKeyID,
+			"aws_secret_access_key": config.AwsSecretAccessKey,
+			"aws_region":            config.AwsRegion,
+			"table_uri":             config.TableURI,
+		}, true
+	case *unstructured.ElasticsearchConnectorConfig:
+		return "elasticsearch", Object{
+			"hosts":      config.Hosts,
+			"index_name": config.IndexName,
+			"es_api_key": config.ESAPIKey,
+		}, true
+	case *unstructured.GCSDestinationConnectorConfig:
+		return "gcs", Object{
+			"remote_url":          config.RemoteURL,
+			"service_account_key": config.ServiceAccountKey,
+		}, true
+	case *unstructured.KafkaCloudDestinationConnectorConfig:
+		return "kafka_cloud", Object{
+			"bootstrap_servers": config.BootstrapServers,
+			"port":              config.Port,
+			"group_id":          config.GroupID,
+			"topic":             config.Topic,
+			"kafka_api_key":     config.KafkaAPIKey,
+			"secret":            config.Secret,
+			"batch_size":        config.BatchSize,
+		}, true
+	case *unstructured.MilvusDestinationConnectorConfig:
+		return "milvus", Object{
+			"uri":             config.URI,
+			"user":            config.User,
+			"token":           config.Token,
+			"password":        config.Password,
+			"db_name":         config.DBName,
+			"collection_name": config.CollectionName,
+			"record_id_key":   config.RecordIDKey,
+		}, true
+	case *unstructured.MongoDBConnectorConfig:
+		return "mongodb", Object{
+			"database":   config.Database,
+			"collection": config.Collection,
+			"uri":        config.URI,
+		}, true
+	case *unstructured.MotherduckDestinationConnectorConfig:
+		return "motherduck", Object{
+			"account":       config.Account,
+			"role":          config.Role,
+			"user":          config.User,
+			"password":      config.Password,
+			"host":          config.Host,
+			"port":          config.Port,
+			"database":      config.Database,
+			"schema":        config.Schema,
+			"table_name":    config.TableName,
+			"batch_size":    config.BatchSize,
+			"record_id_key": config.RecordIDKey,
+		}, true
+	case *unstructured.Neo4jDestinationConnectorConfig:
+		return "neo4j", Object{
+			"uri":        config.URI,
+			"database":   config.Database,
+			"username":   config.Username,
+			"password":   config.Password,
+			"batch_size": config.BatchSize,
+		}, true
+	case *unstructured.OneDriveDestinationConnectorConfig:
+		return "onedrive", Object{
+			"client_id":     config.ClientID,
+			"user_pname":    config.UserPName,
+			"tenant":        config.Tenant,
+			"authority_url": config.AuthorityURL,
+			"client_cred":   config.ClientCred,
+			"remote_url":    config.RemoteURL,
+		}, true
+	case *unstructured.PineconeDestinationConnectorConfig:
+		return "pinecone", Object{
+			"index_name": config.IndexName,
+			"api_key":    config.APIKey,
+			"namespace":  config.Namespace,
+			"batch_size": config.BatchSize,
+		}, true
+	case *unstructured.PostgresDestinationConnectorConfig:
+		return "postgres", Object{
+			"host":       config.Host,
+			"database":   config.Database,
+			"port":       config.Port,
+			"username":   config.Username,
+			"password":   config.Password,
+			"table_name": config.TableName,
+			"batch_size": config.BatchSize,
+		}, true
+	case *unstructured.RedisDestinationConnectorConfig:
+		return "redis", Object{
+			"host":       config.Host,
+			"port":       config.Port,
+			"username":   config.Username,
+			"password":   config.Password,
+			"uri":        config.URI,
+			"database":   config.Database,
+			"ssl":        config.SSL,
+			"batch_size": config.BatchSize,
+		}, true
+	case *unstructured.QdrantCloudDestinationConnectorConfig:
+		return "qdrant_cloud", Object{
+			"url":             config.URL,
+			"api_key":         config.APIKey,
+			"collection_name": config.CollectionName,
+			"batch_size":      config.BatchSize,
+		}, true
+	case *unstructured.S3DestinationConnectorConfig:
+		return "s3", Object{
+			"remote_url":   config.RemoteURL,
+			"anonymous":    config.Anonymous,
+			"key":          config.Key,
+			"secret":       config.Secret,
+			"token":        config.Token,
+			"endpoint_url": config.EndpointURL,
+		}, true
+	case *unstructured.SnowflakeDestinationConnectorConfig:
+		return "snowflake", Object{
+			"account":       config.Account,
+			"role":          config.Role,
+			"user":          config.User,
+			"password":      config.Password,
+			"host":          config.Host,
+			"port":          config.Port,
+			"database":      config.Database,
+			"schema":        config.Schema,
+			"table_name":    config.TableName,
+			"batch_size":    config.BatchSize,
+			"record_id_key": config.RecordIDKey,
+		}, true
+	case *unstructured.WeaviateDestinationConnectorConfig:
+		return "weaviate_cloud", Object{
+			"cluster_url": config.ClusterURL,
+			"api_key":     config.APIKey,
+			"collection":  config.Collection,
+		}, true
+	case *unstructured.IBMWatsonxS3DestinationConnectorConfig:
+		return "ibm_watsonx_s3", Object{
+			"iam_api_key":             config.IAMApiKey,
+			"access_key_id":           config.AccessKeyID,
+			"secret_access_key":       config.SecretAccessKey,
+			"iceberg_endpoint":        config.IcebergEndpoint,
+			"object_storage_endpoint": config.ObjectStorageEndpoint,
+			"object_storage_region":   config.ObjectStorageRegion,
+			"catalog":                 config.Catalog,
+			"max_retries_connection":  config.MaxRetriesConnection,
+			"namespace":               config.Namespace,
+			"table":                   config.Table,
+			"max_retries":             config.MaxRetries,
+			"record_id_key":           config.RecordIDKey,
+		}, true
+	}
+
+	return "", nil, false
+}
+
+// destinationConnectorTypes maps connector block names to their API connector types.
+var destinationConnectorTypes = map[string]string{
+	"astradb":                        unstructured.AstraDBConnectorConfigInput{}.Type(),
+	"azure_ai_search":                unstructured.AzureAISearchConnectorConfigInput{}.Type(),
+	"couchbase":                      unstructured.CouchbaseDestinationConnectorConfigInput{}.Type(),
+	"databricks_volumes":             unstructured.DatabricksVolumesConnectorConfigInput{}.Type(),
+	"databricks_volume_delta_tables": unstructured.DatabricksVDTDestinationConnectorConfigInput{}.Type(),
+	"delta_table":                    unstructured.DeltaTableConnectorConfigInput{}.Type(),
+	"elasticsearch":                  unstructured.ElasticsearchConnectorConfigInput{}.Type(),
+	"gcs":                            unstructured.GCSDestinationConnectorConfigInput{}.Type(),
+	"kafka_cloud":                    unstructured.KafkaCloudDestinationConnectorConfigInput{}.Type(),
+	"milvus":                         unstructured.MilvusDestinationConnectorConfigInput{}.Type(),
+	"mongodb":                        unstructured.MongoDBConnectorConfigInput{}.Type(),
+	"motherduck":                     unstructured.MotherduckDestinationConnectorConfigInput{}.Type(),
+	"neo4j":                          unstructured.Neo4jDestinationConnectorConfigInput{}.Type(),
+	"onedrive":                       unstructured.OneDriveDestinationConnectorConfigInput{}.Type(),
+	"pinecone":                       unstructured.PineconeDestinationConnectorConfigInput{}.Type(),
+	"postgres":                       unstructured.PostgresDestinationConnectorConfigInput{}.Type(),
+	"redis":                          unstructured.RedisDestinationConnectorConfigInput{}.Type(),
+	"qdrant_cloud":                   unstructured.QdrantCloudDestinationConnectorConfigInput{}.Type(),
+	"s3":                             unstructured.S3DestinationConnectorConfigInput{}.Type(),
+	"snowflake":                      unstructured.SnowflakeDestinationConnectorConfigInput{}.Type(),
+	"weaviate_cloud":                 unstructured.WeaviateDestinationConnectorConfigInput{}.Type(),
+	"ibm_watsonx_s3":                 unstructured.IBMWatsonxS3DestinationConnectorConfigInput{}.Type(),
+}
@@ -0,0 +1,69 @@
+// Package convert converts between Unstructured API types and the Terraform
+// models of the resources and data sources.
+//
+// API objects are first converted to a canonical, schema-agnostic Object,
+// which Project then fits to the schema of a resource or data source. The
+// resource and data source of the same API object therefore share a single
+// conversion and differ only in their schemas.
+package convert
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// StringPointer returns a pointer to the value of v, or nil when v is null or
+// unknown.
+func StringPointer(v types.String) *string {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+
+	s := v.ValueString()
+
+	return &s
+}
+
+// BoolPointer returns a pointer to the value of v, or nil when v is null or
+// unknown.
+func BoolPointer(v types.Bool) *bool {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+
+	b := v.ValueBool()
+
+	return &b
+}
+
+// Int returns the value of v as an int.
+func Int(v types.Int64) int {
+	return int(v.ValueInt64())
+}
+
+// IntPointer returns a pointer to the value of v as an int, or nil when v is
+// null or unknown.
+func IntPointer(v types.Int64) *int {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+
+	i := int(v.ValueInt64())
+
+	return &i
+}
+
+// Strings returns the elements of a list of strings, or nil when v is null or
+// unknown.
+func Strings(ctx context.Context, v types.List, diags *diag.Diagnostics) []string {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+
+	var s []string
+	diags.Append(v.ElementsAs(ctx, &s, false)...)
+
+	return s
+}
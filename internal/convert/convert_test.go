@@ -0,0 +1,371 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package convert_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/convert"
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/datasource_destination"
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/datasource_source"
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/datasource_workflow"
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/resource_destination"
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/resource_source"
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/resource_workflow"
+	"github.com/aws-gopher/unstructured-sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// TestSourceParity checks that the source resource and data source produce
+// identical values for every connector of the same API object.
+func TestSourceParity(t *testing.T) {
+	resourceSchema := resource_source.SourceResourceSchema(t.Context())
+	dataSourceSchema := datasource_source.SourceDataSourceSchema(t.Context())
+
+	for _, block := range resource_source.SourceConnectors {
+		t.Run(block, func(t *testing.T) {
+			var model resource_source.SourceModel
+			testPopulatedModel(t, tfsdk.State{Schema: resourceSchema}, block, &model)
+
+			config, diags := resource_source.SourceConfigInput(t.Context(), &model)
+			testDiags(t, "SourceConfigInput()", diags)
+
+			var source unstructured.Source
+			testAPIObject(t, config.Type(), config, &source)
+
+			resourceState := tfsdk.State{Schema: resourceSchema}
+			resourceModel, diags := resource_source.SourceToModel(t.Context(), &source)
+			testDiags(t, "resource SourceToModel()", diags)
+			testDiags(t, "resource State.Set()", resourceState.Set(t.Context(), resourceModel))
+
+			dataSourceState := tfsdk.State{Schema: dataSourceSchema}
+			dataSourceModel, diags := datasource_source.SourceToModel(t.Context(), &source)
+			testDiags(t, "data source SourceToModel()", diags)
+			testDiags(t, "data source State.Set()", dataSourceState.Set(t.Context(), dataSourceModel))
+
+			testCompare(t, "", resourceState.Raw, dataSourceState.Raw)
+		})
+	}
+}
+
+// TestDestinationParity is TestSourceParity for destinations.
+func TestDestinationParity(t *testing.T) {
+	resourceSchema := resource_destination.DestinationResourceSchema(t.Context())
+	dataSourceSchema := datasource_destination.DestinationDataSourceSchema(t.Context())
+
+	for _, block := range resource_destination.DestinationConnectors {
+		t.Run(block, func(t *testing.T) {
+			var model resource_destination.DestinationModel
+			testPopulatedModel(t, tfsdk.State{Schema: resourceSchema}, block, &model)
+
+			config, diags := resource_destination.DestinationConfigInput(t.Context(), &model)
+			testDiags(t, "DestinationConfigInput()", diags)
+
+			var destination unstructured.Destination
+			testAPIObject(t, config.Type(), config, &destination)
+
+			resourceState := tfsdk.State{Schema: resourceSchema}
+			resourceModel, diags := resource_destination.DestinationToModel(t.Context(), &destination)
+			testDiags(t, "resource DestinationToModel()", diags)
+			testDiags(t, "resource State.Set()", resourceState.Set(t.Context(), resourceModel))
+
+			dataSourceState := tfsdk.State{Schema: dataSourceSchema}
+			dataSourceModel, diags := datasource_destination.DestinationToModel(t.Context(), &destination)
+			testDiags(t, "data source DestinationToModel()", diags)
+			testDiags(t, "data source State.Set()", dataSourceState.Set(t.Context(), dataSourceModel))
+
+			testCompare(t, "", resourceState.Raw, dataSourceState.Raw)
+		})
+	}
+}
+
+// TestWorkflowParity checks that the workflow resource and data source produce
+// identical values for the same API object, and that each gets the schedule
+// in the form of its schema.
+func TestWorkflowParity(t *testing.T) {
+	id := "node-1"
+	workflowType := unstructured.WorkflowTypeCustom
+	reprocessAll := true
+
+	workflow := &unstructured.Workflow{
+		ID:           "workflow-1",
+		Name:         "workflow",
+		Sources:      []string{"source-1"},
+		Destinations: []string{"destination-1"},
+		WorkflowType: &workflowType,
+		WorkflowNodes: []unstructured.WorkflowNode{
+			{ID: &id, Name: "Partitioner", Type: "partition", Subtype: "vlm", Settings: map[string]any{"strategy": "auto"}},
+			{Name: "Chunker", Type: "chunk", Subtype: "chunk_by_title"},
+		},
+		Schedule: &unstructured.WorkflowSchedule{
+			CronTabEntries: []unstructured.CronTabEntry{{CronExpression: "0 0 * * *"}},
+		},
+		Status:       unstructured.WorkflowStateActive,
+		CreatedAt:    time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+		UpdatedAt:    time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+		ReprocessAll: &reprocessAll,
+	}
+
+	resource, diags := resource_workflow.WorkflowToModel(t.Context(), workflow)
+	testDiags(t, "resource WorkflowToModel()", diags)
+
+	dataSource, diags := datasource_workflow.WorkflowToModel(t.Context(), workflow)
+	testDiags(t, "data source WorkflowToModel()", diags)
+
+	resourceState := tfsdk.State{Schema: resource_workflow.WorkflowResourceSchema(t.Context())}
+	testDiags(t, "resource State.Set()", resourceState.Set(t.Context(), resource))
+
+	dataSourceState := tfsdk.State{Schema: datasource_workflow.WorkflowDataSourceSchema(t.Context())}
+	testDiags(t, "data source State.Set()", dataSourceState.Set(t.Context(), dataSource))
+
+	testCompare(t, "", resourceState.Raw, dataSourceState.Raw)
+
+	if got := resource.Schedule.ValueString(); got != "0 0 * * *" {
+		t.Errorf("resource schedule = %q, want the cron expression", got)
+	}
+
+	if got := len(dataSource.Schedule.CrontabEntries.Elements()); got != 1 {
+		t.Errorf("data source schedule has %d crontab entries, want 1", got)
+	}
+
+	if got := resource.SourceId.ValueString(); got != "source-1" {
+		t.Errorf("resource source_id = %q, want source-1", got)
+	}
+}
+
+func TestProject(t *testing.T) {
+	typ := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"name":  types.StringType,
+		"count": types.Int64Type,
+		"tags":  types.ListType{ElemType: types.StringType},
+		"mode":  types.StringType,
+	}}
+
+	type model struct {
+		Name  types.String `tfsdk:"name"`
+		Count types.Int64  `tfsdk:"count"`
+		Tags  types.List   `tfsdk:"tags"`
+		Mode  types.String `tfsdk:"mode"`
+	}
+
+	tests := []struct {
+		name    string
+		obj     convert.Object
+		want    model
+		wantErr string
+	}{
+		{
+			name: "values",
+			obj: convert.Object{
+				"name":    "a",
+				"count":   3,
+				"tags":    []string{"x"},
+				"mode":    convert.Variants{convert.Object{"every": "hour"}, "hourly"},
+				"ignored": true,
+			},
+			want: model{
+				Name:  types.StringValue("a"),
+				Count: types.Int64Value(3),
+				Tags:  types.ListValueMust(types.StringType, []attr.Value{types.StringValue("x")}),
+				Mode:  types.StringValue("hourly"),
+			},
+		},
+		{
+			name: "nulls",
+			obj:  convert.Object{"name": (*string)(nil), "tags": []string{}},
+			want: model{
+				Name:  types.StringNull(),
+				Count: types.Int64Null(),
+				Tags:  types.ListNull(types.StringType),
+				Mode:  types.StringNull(),
+			},
+		},
+		{
+			name:    "mismatched type",
+			obj:     convert.Object{"count": "three"},
+			wantErr: "count: cannot convert string",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got model
+			diags := convert.Project(t.Context(), tt.obj, typ, &got)
+
+			if tt.wantErr != "" {
+				if !diags.HasError() || !strings.Contains(fmt.Sprint(diags), tt.wantErr) {
+					t.Fatalf("Project() diagnostics = %v, want %q", diags, tt.wantErr)
+				}
+				return
+			}
+
+			testDiags(t, "Project()", diags)
+
+			if !got.Name.Equal(tt.want.Name) || !got.Count.Equal(tt.want.Count) || !got.Tags.Equal(tt.want.Tags) || !got.Mode.Equal(tt.want.Mode) {
+				t.Errorf("Project() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func testDiags(t *testing.T, what string, diags diag.Diagnostics) {
+	t.Helper()
+
+	if diags.HasError() {
+		t.Fatalf("%s diagnostics = %v", what, diags)
+	}
+}
+
+// testPopulatedModel sets model to a value of the schema of state with only
+// the name and the given connector block set, every attribute of the block
+// having a distinct value.
+func testPopulatedModel(t *testing.T, state tfsdk.State, block string, model any) {
+	t.Helper()
+
+	typ, ok := state.Schema.Type().TerraformType(t.Context()).(tftypes.Object)
+	if !ok {
+		t.Fatal("schema is not an object")
+	}
+
+	attrs := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+	for name, attrType := range typ.AttributeTypes {
+		attrs[name] = tftypes.NewValue(attrType, nil)
+	}
+	attrs["name"] = tftypes.NewValue(tftypes.String, "connector-name")
+
+	blockType, _ := typ.AttributeTypes[block].(tftypes.Object)
+	blockAttrs := make(map[string]tftypes.Value, len(blockType.AttributeTypes))
+	for name, attrType := range blockType.AttributeTypes {
+		switch {
+		case attrType.Is(tftypes.String):
+			blockAttrs[name] = tftypes.NewValue(attrType, name+"-value")
+		case attrType.Is(tftypes.Number):
+			blockAttrs[name] = tftypes.NewValue(attrType, big.NewFloat(float64(len(name))))
+		case attrType.Is(tftypes.Bool):
+			blockAttrs[name] = tftypes.NewValue(attrType, true)
+		case attrType.Is(tftypes.List{ElementType: tftypes.String}):
+			blockAttrs[name] = tftypes.NewValue(attrType, []tftypes.Value{tftypes.NewValue(tftypes.String, name+"-1")})
+		default:
+			t.Fatalf("unsupported attribute type %s for %s.%s", attrType, block, name)
+		}
+	}
+	attrs[block] = tftypes.NewValue(blockType, blockAttrs)
+
+	state.Raw = tftypes.NewValue(typ, attrs)
+	testDiags(t, "State.Get()", state.Get(t.Context(), model))
+}
+
+// testAPIObject decodes config as the API would return it into out.
+func testAPIObject(t *testing.T, typ string, config, out any) {
+	t.Helper()
+
+	b, err := json.Marshal(map[string]any{
+		"id":         "connector-id",
+		"name":       "connector-name",
+		"type":       typ,
+		"config":     config,
+		"created_at": time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+		"updated_at": time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := json.Unmarshal(b, out); err != nil {
+		t.Fatalf("decoding the simulated response: %v", err)
+	}
+}
+
+// testCompare reports every difference between the attributes that a and b
+// have in common, recursing into objects and lists of objects.
+func testCompare(t *testing.T, path string, a, b tftypes.Value) {
+	t.Helper()
+
+	if a.IsNull() || b.IsNull() || !a.Type().Is(tftypes.Object{}) || !b.Type().Is(tftypes.Object{}) {
+		if a.Type().Is(tftypes.List{}) && b.Type().Is(tftypes.List{}) && !a.IsNull() && !b.IsNull() {
+			var aElems, bElems []tftypes.Value
+			if err := a.As(&aElems); err != nil {
+				t.Fatal(err)
+			}
+			if err := b.As(&bElems); err != nil {
+				t.Fatal(err)
+			}
+
+			if len(aElems) != len(bElems) {
+				t.Errorf("%s has %d elements in the resource and %d in the data source", path, len(aElems), len(bElems))
+				return
+			}
+
+			for i := range aElems {
+				testCompare(t, fmt.Sprintf("%s[%d]", path, i), aElems[i], bElems[i])
+			}
+
+			return
+		}
+
+		if a.IsNull() != b.IsNull() || (a.Type().Equal(b.Type()) && !a.Equal(b)) {
+			t.Errorf("%s = %s in the resource, %s in the data source", path, a, b)
+		}
+
+		return
+	}
+
+	var aAttrs, bAttrs map[string]tftypes.Value
+	if err := a.As(&aAttrs); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.As(&bAttrs); err != nil {
+		t.Fatal(err)
+	}
+
+	names := make([]string, 0, len(aAttrs))
+	for name := range aAttrs {
+		if _, ok := bAttrs[name]; ok {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		testCompare(t, strings.TrimPrefix(path+"."+name, "."), aAttrs[name], bAttrs[name])
+	}
+}
+
+func TestStrings(t *testing.T) {
+	tests := []struct {
+		name    string
+		list    types.List
+		want    []string
+		wantErr bool
+	}{
+		{name: "null", list: types.ListNull(types.StringType)},
+		{name: "values", list: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a"), types.StringValue("b")}), want: []string{"a", "b"}},
+		{name: "unknown element", list: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a"), types.StringUnknown()}), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			got := convert.Strings(t.Context(), tt.list, &diags)
+
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("Strings() diagnostics = %v, want an error: %t", diags, tt.wantErr)
+			}
+
+			if !tt.wantErr && !slices.Equal(got, tt.want) {
+				t.Errorf("Strings() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package convert
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws-gopher/unstructured-sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// RawConfigInput is a connector config input that sends Config to the API as
+// is. The SDK only accepts its own config inputs, so RawConfigInput embeds one
+// that is valid for both sources and destinations and overrides its type and
+// JSON encoding.
+type RawConfigInput struct {
+	unstructured.MongoDBConnectorConfigInput
+
+	ConnectorType string
+	Config        json.RawMessage
+}
+
+var (
+	_ unstructured.SourceConfigInput      = RawConfigInput{}
+	_ unstructured.DestinationConfigInput = RawConfigInput{}
+)
+
+// Type returns the connector type.
+func (c RawConfigInput) Type() string { return c.ConnectorType }
+
+// MarshalJSON returns the config.
+func (c RawConfigInput) MarshalJSON() ([]byte, error) { return c.Config, nil }
+
+// customConnectorAttrTypes are the attribute types of the custom connector
+// block.
+var customConnectorAttrTypes = map[string]attr.Type{
+	"type":        basetypes.StringType{},
+	"config_json": jsontypes.NormalizedType{},
+}
+
+// CustomConnectorType is the type of the custom connector block. The code
+// generator drops the custom types of nested attributes, so the block has a
+// type of its own to keep the JSON semantic equality of config_json.
+type CustomConnectorType struct {
+	basetypes.ObjectType
+}
+
+var _ basetypes.ObjectTypable = CustomConnectorType{}
+
+// NewCustomConnectorType returns the type of the custom connector block.
+func NewCustomConnectorType() CustomConnectorType {
+	return CustomConnectorType{ObjectType: basetypes.ObjectType{AttrTypes: customConnectorAttrTypes}}
+}
+
+func (t CustomConnectorType) Equal(o attr.Type) bool {
+	other, ok := o.(CustomConnectorType)
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t CustomConnectorType) String() string {
+	return "convert.CustomConnectorType"
+}
+
+func (t CustomConnectorType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	return CustomConnectorValue{ObjectValue: in}, nil
+}
+
+func (t CustomConnectorType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	v, err := t.ObjectType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	o, ok := v.(basetypes.ObjectValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type %T", v)
+	}
+
+	return CustomConnectorValue{ObjectValue: o}, nil
+}
+
+func (t CustomConnectorType) ValueType(ctx context.Context) attr.Value {
+	return CustomConnectorValue{}
+}
+
+// CustomConnectorValue is the value of the custom connector block. Its zero
+// value is null.
+type CustomConnectorValue struct {
+	basetypes.ObjectValue
+}
+
+var _ basetypes.ObjectValuable = CustomConnectorValue{}
+
+// NewCustomConnectorValue returns a custom connector block of type typ with
+// the config configJSON.
+func NewCustomConnectorValue(typ string, configJSON string) CustomConnectorValue {
+	return CustomConnectorValue{ObjectValue: basetypes.NewObjectValueMust(customConnectorAttrTypes, map[string]attr.Value{
+		"type":        basetypes.NewStringValue(typ),
+		"config_json": jsontypes.NewNormalizedValue(configJSON),
+	})}
+}
+
+func (v CustomConnectorValue) Equal(o attr.Value) bool {
+	other, ok := o.(CustomConnectorValue)
+	if !ok {
+		return false
+	}
+
+	return v.object().Equal(other.object())
+}
+
+func (v CustomConnectorValue) Type(ctx context.Context) attr.Type {
+	return NewCustomConnectorType()
+}
+
+func (v CustomConnectorValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	return v.object(), nil
+}
+
+func (v CustomConnectorValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	return v.object().ToTerraformValue(ctx)
+}
+
+// object returns the underlying object, with the attribute types of the
+// block even when v is the zero value.
+func (v CustomConnectorValue) object() basetypes.ObjectValue {
+	if v.ObjectValue.IsNull() {
+		return basetypes.NewObjectNull(customConnectorAttrTypes)
+	}
+
+	if v.ObjectValue.IsUnknown() {
+		return basetypes.NewObjectUnknown(customConnectorAttrTypes)
+	}
+
+	return v.ObjectValue
+}
+
+// ConnectorType returns the type attribute.
+func (v CustomConnectorValue) ConnectorType() basetypes.StringValue {
+	s, _ := v.Attributes()["type"].(basetypes.StringValue)
+	return s
+}
+
+// ConfigJSON returns the config_json attribute.
+func (v CustomConnectorValue) ConfigJSON() jsontypes.Normalized {
+	s, _ := v.Attributes()["config_json"].(jsontypes.Normalized)
+	return s
+}
@@ -0,0 +1,191 @@
+package convert
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Object is the canonical form of an API object, keyed by attribute name.
+// Values are nil, strings, ints, bools, pointers to them, string slices,
+// Objects, Object slices or Variants.
+type Object map[string]any
+
+// Variants holds alternative forms of an attribute that schemas model
+// differently. Project uses the first one that fits the target type.
+type Variants []any
+
+// Project sets target, a pointer to a model of the object type typ, from obj.
+// Attributes of typ missing from obj are null, attributes of obj missing from
+// typ are ignored, and empty lists are null.
+func Project(ctx context.Context, obj Object, typ attr.Type, target any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	raw, err := value(typ.TerraformType(ctx), obj)
+	if err != nil {
+		diags.AddError("Unexpected API response", err.Error())
+		return diags
+	}
+
+	v, err := typ.ValueFromTerraform(ctx, raw)
+	if err != nil {
+		diags.AddError("Unexpected API response", err.Error())
+		return diags
+	}
+
+	o, ok := v.(basetypes.ObjectValuable)
+	if !ok {
+		diags.AddError("Unexpected API response", fmt.Sprintf("cannot project an object onto %T", v))
+		return diags
+	}
+
+	ov, d := o.ToObjectValue(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(ov.As(ctx, target, basetypes.ObjectAsOptions{})...)
+
+	return diags
+}
+
+// value converts v to a value of type typ.
+func value(typ tftypes.Type, v any) (tftypes.Value, error) {
+	if variants, ok := v.(Variants); ok {
+		var err error
+		for _, variant := range variants {
+			var tv tftypes.Value
+			if tv, err = value(typ, variant); err == nil {
+				return tv, nil
+			}
+		}
+
+		return tftypes.Value{}, err
+	}
+
+	v = deref(v)
+	if v == nil {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	switch {
+	case typ.Is(tftypes.String):
+		if s, ok := v.(string); ok {
+			return tftypes.NewValue(typ, s), nil
+		}
+
+	case typ.Is(tftypes.Number):
+		switch n := v.(type) {
+		case int:
+			return tftypes.NewValue(typ, big.NewFloat(float64(n))), nil
+		case int64:
+			return tftypes.NewValue(typ, big.NewFloat(float64(n))), nil
+		case float64:
+			return tftypes.NewValue(typ, big.NewFloat(n)), nil
+		}
+
+	case typ.Is(tftypes.Bool):
+		if b, ok := v.(bool); ok {
+			return tftypes.NewValue(typ, b), nil
+		}
+
+	case typ.Is(tftypes.List{}):
+		list, _ := typ.(tftypes.List)
+		return listValue(typ, list.ElementType, v)
+
+	case typ.Is(tftypes.Set{}):
+		set, _ := typ.(tftypes.Set)
+		return listValue(typ, set.ElementType, v)
+
+	case typ.Is(tftypes.Object{}):
+		obj, ok := v.(Object)
+		if !ok {
+			break
+		}
+
+		objType, _ := typ.(tftypes.Object)
+		attrs := make(map[string]tftypes.Value, len(objType.AttributeTypes))
+		for name, attrType := range objType.AttributeTypes {
+			av, err := value(attrType, obj[name])
+			if err != nil {
+				return tftypes.Value{}, fmt.Errorf("%s: %w", name, err)
+			}
+
+			attrs[name] = av
+		}
+
+		return tftypes.NewValue(typ, attrs), nil
+	}
+
+	return tftypes.Value{}, fmt.Errorf("cannot convert %T to %s", v, typ)
+}
+
+func listValue(typ, elemType tftypes.Type, v any) (tftypes.Value, error) {
+	var elems []any
+	switch v := v.(type) {
+	case []string:
+		for _, e := range v {
+			elems = append(elems, e)
+		}
+	case []Object:
+		for _, e := range v {
+			elems = append(elems, e)
+		}
+	case []any:
+		elems = v
+	default:
+		return tftypes.Value{}, fmt.Errorf("cannot convert %T to %s", v, typ)
+	}
+
+	if len(elems) == 0 {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	values := make([]tftypes.Value, 0, len(elems))
+	for i, e := range elems {
+		ev, err := value(elemType, e)
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("[%d]: %w", i, err)
+		}
+
+		values = append(values, ev)
+	}
+
+	return tftypes.NewValue(typ, values), nil
+}
+
+// deref returns the value v points to, or nil for nil pointers.
+func deref(v any) any {
+	switch p := v.(type) {
+	case *string:
+		if p != nil {
+			return *p
+		}
+	case *int:
+		if p != nil {
+			return *p
+		}
+	case *int64:
+		if p != nil {
+			return *p
+		}
+	case *bool:
+		if p != nil {
+			return *p
+		}
+	case Object:
+		if p != nil {
+			return p
+		}
+	default:
+		return v
+	}
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package convert
+
+// SecretAttributes are the connector attributes that hold credentials, by the
+// name that both their connector blocks and the API give them. Export writes
+// variables in their place, and recorded API interactions are scrubbed of
+// them.
+var SecretAttributes = map[string]bool{
+	"account_key":           true,
+	"api_key":               true,
+	"api_token":             true,
+	"aws_secret_access_key": true,
+	"box_app_config":        true,
+	"client_cred":           true,
+	"client_secret":         true,
+	"connection_string":     true,
+	"es_api_key":            true,
+	"iam_api_key":           true,
+	"kafka_api_key":         true,
+	"key":                   true,
+	"password":              true,
+	"private_key":           true,
+	"sas_token":             true,
+	"secret":                true,
+	"secret_access_key":     true,
+	"service_account_key":   true,
+	"token":                 true,
+}
@@ -0,0 +1,85 @@
+package convert
+
+import (
+	"time"
+
+	"github.com/aws-gopher/unstructured-sdk-go"
+)
+
+// Workflow returns the canonical form of workflow.
+func Workflow(workflow *unstructured.Workflow) Object {
+	// The resource schema still has the older single source and destination
+	// attributes alongside the lists.
+	var sourceID, destinationID *string
+	if len(workflow.Sources) > 0 {
+		sourceID = &workflow.Sources[0]
+	}
+	if len(workflow.Destinations) > 0 {
+		destinationID = &workflow.Destinations[0]
+	}
+
+	// Workflows without a type or reprocess setting read back with the API
+	// defaults rather than as null.
+	workflowType := ""
+	if workflow.WorkflowType != nil {
+		workflowType = string(*workflow.WorkflowType)
+	}
+
+	reprocessAll := false
+	if workflow.ReprocessAll != nil {
+		reprocessAll = *workflow.ReprocessAll
+	}
+
+	nodes := make([]Object, 0, len(workflow.WorkflowNodes))
+	for _, node := range workflow.WorkflowNodes {
+		settings := Object(node.Settings)
+		if settings == nil {
+			settings = Object{}
+		}
+
+		nodes = append(nodes, Object{
+			"id":       node.ID,
+			"name":     node.Name,
+			"type":     node.Type,
+			"subtype":  node.Subtype,
+			"settings": settings,
+		})
+	}
+
+	return Object{
+		"id":             workflow.ID,
+		"name":           workflow.Name,
+		"status":         string(workflow.Status),
+		"workflow_type":  workflowType,
+		"sources":        workflow.Sources,
+		"destinations":   workflow.Destinations,
+		"source_id":      sourceID,
+		"destination_id": destinationID,
+		"reprocess_all":  reprocessAll,
+		"created_at":     workflow.CreatedAt.Format(time.RFC3339),
+		"updated_at":     workflow.UpdatedAt.Format(time.RFC3339),
+		"workflow_nodes": nodes,
+		"schedule":       schedule(workflow.Schedule),
+	}
+}
+
+// schedule returns the canonical form of a workflow schedule: either its
+// crontab entries or, for schemas that model the schedule as a string, the
+// first cron expression.
+func schedule(s *unstructured.WorkflowSchedule) any {
+	if s == nil {
+		return nil
+	}
+
+	entries := make([]Object, 0, len(s.CronTabEntries))
+	for _, entry := range s.CronTabEntries {
+		entries = append(entries, Object{"cron_expression": entry.CronExpression})
+	}
+
+	var expression *string
+	if len(s.CronTabEntries) > 0 {
+		expression = &s.CronTabEntries[0].CronExpression
+	}
+
+	return Variants{Object{"crontab_entries": entries}, expression}
+}
@@ -62,6 +62,12 @@ func DestinationDataSourceSchema(ctx context.Context) schema.Schema {
 				},
 				Computed: true,
 			},
+			"config_json": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				Description:         "The connector config as JSON when the destination has a connector type that none of the connector blocks model, and null otherwise",
+				MarkdownDescription: "The connector config as JSON when the destination has a connector type that none of the connector blocks model, and null otherwise",
+			},
 			"couchbase": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
 					"batch_size": schema.Int64Attribute{
@@ -275,7 +281,10 @@ func DestinationDataSourceSchema(ctx context.Context) schema.Schema {
 				Computed: true,
 			},
 			"id": schema.StringAttribute{
-				Required: true,
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the destination to look up. Exactly one of `id` and `name` must be set",
+				MarkdownDescription: "The ID of the destination to look up. Exactly one of `id` and `name` must be set",
 			},
 			"kafka_cloud": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
@@ -402,7 +411,10 @@ func DestinationDataSourceSchema(ctx context.Context) schema.Schema {
 				Computed: true,
 			},
 			"name": schema.StringAttribute{
-				Computed: true,
+				Optional:            true,
+				Computed:            true,
+				Description:         "The name of the destination to look up. Exactly one of `id` and `name` must be set, and exactly one destination must have the name",
+				MarkdownDescription: "The name of the destination to look up. Exactly one of `id` and `name` must be set, and exactly one destination must have the name",
 			},
 			"neo4j": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
@@ -644,6 +656,12 @@ func DestinationDataSourceSchema(ctx context.Context) schema.Schema {
 				},
 				Computed: true,
 			},
+			"type": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The connector type of the destination, named as the API or as the connector block names it, such as `kafka-cloud` or `kafka_cloud`. When set, the destination looked up must have this type",
+				MarkdownDescription: "The connector type of the destination, named as the API or as the connector block names it, such as `kafka-cloud` or `kafka_cloud`. When set, the destination looked up must have this type",
+			},
 			"updated_at": schema.StringAttribute{
 				Computed: true,
 			},
@@ -673,6 +691,7 @@ func DestinationDataSourceSchema(ctx context.Context) schema.Schema {
 type DestinationModel struct {
 	Astradb                     AstradbValue                     `tfsdk:"astradb"`
 	AzureAiSearch               AzureAiSearchValue               `tfsdk:"azure_ai_search"`
+	ConfigJson                  types.String                     `tfsdk:"config_json"`
 	Couchbase                   CouchbaseValue                   `tfsdk:"couchbase"`
 	CreatedAt                   types.String                     `tfsdk:"created_at"`
 	DatabricksVolumeDeltaTables DatabricksVolumeDeltaTablesValue `tfsdk:"databricks_volume_delta_tables"`
@@ -695,6 +714,7 @@ type DestinationModel struct {
 	Redis                       RedisValue                       `tfsdk:"redis"`
 	S3                          S3Value                          `tfsdk:"s3"`
 	Snowflake                   SnowflakeValue                   `tfsdk:"snowflake"`
+	Type                        types.String                     `tfsdk:"type"`
 	UpdatedAt                   types.String                     `tfsdk:"updated_at"`
 	WeaviateCloud               WeaviateCloudValue               `tfsdk:"weaviate_cloud"`
 }
@@ -65,6 +65,12 @@ func SourceDataSourceSchema(ctx context.Context) schema.Schema {
 				},
 				Computed: true,
 			},
+			"config_json": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				Description:         "The connector config as JSON when the source has a connector type that none of the connector blocks model, and null otherwise",
+				MarkdownDescription: "The connector config as JSON when the source has a connector type that none of the connector blocks model, and null otherwise",
+			},
 			"confluence": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
 					"api_token": schema.StringAttribute{
@@ -259,7 +265,10 @@ func SourceDataSourceSchema(ctx context.Context) schema.Schema {
 				Computed: true,
 			},
 			"id": schema.StringAttribute{
-				Required: true,
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the source to look up. Exactly one of `id` and `name` must be set",
+				MarkdownDescription: "The ID of the source to look up. Exactly one of `id` and `name` must be set",
 			},
 			"jira": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
@@ -356,7 +365,10 @@ func SourceDataSourceSchema(ctx context.Context) schema.Schema {
 				Computed: true,
 			},
 			"name": schema.StringAttribute{
-				Computed: true,
+				Optional:            true,
+				Computed:            true,
+				Description:         "The name of the source to look up. Exactly one of `id` and `name` must be set, and exactly one source must have the name",
+				MarkdownDescription: "The name of the source to look up. Exactly one of `id` and `name` must be set, and exactly one source must have the name",
 			},
 			"onedrive": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
@@ -590,6 +602,12 @@ func SourceDataSourceSchema(ctx context.Context) schema.Schema {
 				},
 				Computed: true,
 			},
+			"type": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The connector type of the source, named as the API or as the connector block names it, such as `kafka-cloud` or `kafka_cloud`. When set, the source looked up must have this type",
+				MarkdownDescription: "The connector type of the source, named as the API or as the connector block names it, such as `kafka-cloud` or `kafka_cloud`. When set, the source looked up must have this type",
+			},
 			"updated_at": schema.StringAttribute{
 				Computed: true,
 			},
@@ -625,6 +643,7 @@ func SourceDataSourceSchema(ctx context.Context) schema.Schema {
 type SourceModel struct {
 	Azure             AzureValue             `tfsdk:"azure"`
 	Box               BoxValue               `tfsdk:"box"`
+	ConfigJson        types.String           `tfsdk:"config_json"`
 	Confluence        ConfluenceValue        `tfsdk:"confluence"`
 	Couchbase         CouchbaseValue         `tfsdk:"couchbase"`
 	CreatedAt         types.String           `tfsdk:"created_at"`
@@ -645,6 +664,7 @@ type SourceModel struct {
 	Salesforce        SalesforceValue        `tfsdk:"salesforce"`
 	Sharepoint        SharepointValue        `tfsdk:"sharepoint"`
 	Snowflake         SnowflakeValue         `tfsdk:"snowflake"`
+	Type              types.String           `tfsdk:"type"`
 	UpdatedAt         types.String           `tfsdk:"updated_at"`
 	Zendesk           ZendeskValue           `tfsdk:"zendesk"`
 }
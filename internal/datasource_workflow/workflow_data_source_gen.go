@@ -26,10 +26,16 @@ func WorkflowDataSourceSchema(ctx context.Context) schema.Schema {
 				Computed:    true,
 			},
 			"id": schema.StringAttribute{
-				Required: true,
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the workflow to look up. Exactly one of `id` and `name` must be set",
+				MarkdownDescription: "The ID of the workflow to look up. Exactly one of `id` and `name` must be set",
 			},
 			"name": schema.StringAttribute{
-				Computed: true,
+				Optional:            true,
+				Computed:            true,
+				Description:         "The name of the workflow to look up. Exactly one of `id` and `name` must be set, and exactly one workflow must have the name",
+				MarkdownDescription: "The name of the workflow to look up. Exactly one of `id` and `name` must be set, and exactly one workflow must have the name",
 			},
 			"reprocess_all": schema.BoolAttribute{
 				Computed: true,
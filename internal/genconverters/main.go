@@ -0,0 +1,590 @@
+// Command genconverters generates the conversions between the connector
+// blocks of the source and destination models and the Unstructured SDK
+// connector configs: from resource models to config inputs, and from configs
+// to the canonical form in package convert that both the resources and the
+// data sources project from.
+//
+// The connector blocks and their attributes come from the provider code
+// specification. A mapping file names the SDK config type of each block and
+// lists the attributes whose JSON field in the SDK has a different name, or
+// which the SDK does not support at all. Every other attribute must match a
+// JSON field of the same name in both the config and its input type.
+// Optional attributes that are not computed must read back as null when
+// unset, so their config field must be a pointer or a list.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+const sdkModule = "github.com/aws-gopher/unstructured-sdk-go"
+
+func main() {
+	specPath := flag.String("spec", "provider-code-spec.json", "path to the provider code specification")
+	mappingPath := flag.String("mapping", "converters.json", "path to the connector mapping file")
+	output := flag.String("output", "internal", "directory holding the generated resource and data source packages")
+	flag.Parse()
+
+	log.SetFlags(0)
+	log.SetPrefix("genconverters: ")
+
+	if err := run(*specPath, *mappingPath, *output); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(specPath, mappingPath, output string) error {
+	var spec specification
+	if err := readJSON(specPath, &spec); err != nil {
+		return err
+	}
+
+	var m mapping
+	if err := readJSON(mappingPath, &m); err != nil {
+		return err
+	}
+
+	sdk, err := loadSDK()
+	if err != nil {
+		return err
+	}
+
+	canonical := map[string][]connector{}
+
+	for _, kind := range []string{"source", "destination"} {
+		resource, err := resolve(kind, find(spec.Resources, kind), m[kind], sdk)
+		if err != nil {
+			return fmt.Errorf("resource: %w", err)
+		}
+
+		datasource, err := resolve(kind, find(spec.Datasources, kind), m[kind], sdk)
+		if err != nil {
+			return fmt.Errorf("data source: %w", err)
+		}
+
+		if err := writeInputs(filepath.Join(output, "resource_"+kind, kind+"_converters_gen.go"), kind, resource); err != nil {
+			return err
+		}
+
+		canonical[kind] = merge(resource, datasource)
+	}
+
+	return writeCanonical(filepath.Join(output, "convert", "connectors_gen.go"), canonical)
+}
+
+func readJSON(path string, v any) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(b, v); err != nil {
+		return fmt.Errorf("decoding %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// specification is the part of the provider code specification that
+// describes connector blocks.
+type specification struct {
+	Resources   []specSchema `json:"resources"`
+	Datasources []specSchema `json:"datasources"`
+}
+
+type specSchema struct {
+	Name   string `json:"name"`
+	Schema struct {
+		Attributes []specAttribute `json:"attributes"`
+	} `json:"schema"`
+}
+
+type specAttribute struct {
+	Name         string     `json:"name"`
+	String       *specValue `json:"string"`
+	Int64        *specValue `json:"int64"`
+	Bool         *specValue `json:"bool"`
+	List         *specValue `json:"list"`
+	SingleNested *struct {
+		Attributes []specAttribute `json:"attributes"`
+	} `json:"single_nested"`
+}
+
+type specValue struct {
+	ComputedOptionalRequired string `json:"computed_optional_required"`
+	ElementType              *struct {
+		String *struct{} `json:"string"`
+	} `json:"element_type"`
+}
+
+func find(schemas []specSchema, name string) []specAttribute {
+	for _, s := range schemas {
+		if s.Name == name {
+			return s.Schema.Attributes
+		}
+	}
+
+	return nil
+}
+
+// mapping maps "source" and "destination" to their connector blocks.
+type mapping map[string]map[string]connectorMapping
+
+type connectorMapping struct {
+	// Raw marks a block that holds a connector type and its config as JSON
+	// rather than the attributes of an SDK config. Its conversion is written
+	// by hand, and it is left out of the generated code.
+	Raw bool `json:"raw"`
+
+	// Config is the SDK config type of the block. Its input type is the same
+	// name with an "Input" suffix.
+	Config string `json:"config"`
+
+	// Fields maps attribute names to SDK JSON field names where they differ.
+	Fields map[string]string `json:"fields"`
+
+	// Unsupported lists attributes the SDK has no field for. They are never
+	// sent, and the resources keep their configured values.
+	Unsupported []string `json:"unsupported"`
+
+	// WriteOnly lists attributes the SDK sends but does not read back. The
+	// resources keep their configured values.
+	WriteOnly []string `json:"write_only"`
+}
+
+// sdkField is an exported field of an SDK struct.
+type sdkField struct {
+	Name string
+	Type string
+}
+
+// loadSDK returns the fields of every struct type in the SDK package, keyed by
+// type name and then JSON field name.
+func loadSDK() (map[string]map[string]sdkField, error) {
+	out, err := exec.Command("go", "list", "-m", "-f", "{{.Dir}}", sdkModule).Output()
+	if err != nil {
+		return nil, fmt.Errorf("locating %s: %w", sdkModule, err)
+	}
+
+	dir := strings.TrimSpace(string(out))
+
+	pkgs, err := parser.ParseDir(token.NewFileSet(), dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", dir, err)
+	}
+
+	structs := map[string]map[string]sdkField{}
+
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			ast.Inspect(file, func(n ast.Node) bool {
+				spec, ok := n.(*ast.TypeSpec)
+				if !ok {
+					return true
+				}
+
+				st, ok := spec.Type.(*ast.StructType)
+				if !ok {
+					return false
+				}
+
+				fields := map[string]sdkField{}
+				for _, f := range st.Fields.List {
+					if len(f.Names) != 1 || !f.Names[0].IsExported() || f.Tag == nil {
+						continue
+					}
+
+					tag := reflect.StructTag(strings.Trim(f.Tag.Value, "`")).Get("json")
+					name, _, _ := strings.Cut(tag, ",")
+					if name == "" || name == "-" {
+						continue
+					}
+
+					fields[name] = sdkField{Name: f.Names[0].Name, Type: types.ExprString(f.Type)}
+				}
+
+				structs[spec.Name.Name] = fields
+
+				return false
+			})
+		}
+	}
+
+	return structs, nil
+}
+
+// connector is a connector block resolved against the SDK.
+type connector struct {
+	Block  string
+	Field  string
+	Config string
+	Attrs  []attribute
+}
+
+type attribute struct {
+	Name     string
+	Field    string
+	Type     string // "string", "int64", "bool" or "list"
+	Computed bool   // computed only, never sent to the API
+
+	// Input and Output are the SDK fields of the attribute in the config input
+	// and config types, or nil when the SDK does not support the attribute.
+	Input  *sdkField
+	Output *sdkField
+}
+
+// resolve matches the connector blocks in attrs with their SDK fields, reporting
+// every attribute it cannot map.
+func resolve(kind string, attrs []specAttribute, blocks map[string]connectorMapping, sdk map[string]map[string]sdkField) ([]connector, error) {
+	var connectors []connector
+	var errs []string
+	seen := map[string]bool{}
+
+	for _, a := range attrs {
+		if a.SingleNested == nil {
+			continue
+		}
+
+		seen[a.Name] = true
+
+		m, ok := blocks[a.Name]
+		if !ok {
+			errs = append(errs, fmt.Sprintf("%s.%s: no mapping", kind, a.Name))
+			continue
+		}
+
+		if m.Raw {
+			continue
+		}
+
+		input, ok := sdk[m.Config+"Input"]
+		if !ok {
+			errs = append(errs, fmt.Sprintf("%s.%s: unknown SDK type %sInput", kind, a.Name, m.Config))
+			continue
+		}
+
+		output, ok := sdk[m.Config]
+		if !ok {
+			errs = append(errs, fmt.Sprintf("%s.%s: unknown SDK type %s", kind, a.Name, m.Config))
+			continue
+		}
+
+		c := connector{Block: a.Name, Field: goName(a.Name), Config: m.Config}
+
+		for _, na := range a.SingleNested.Attributes {
+			at := attribute{Name: na.Name, Field: goName(na.Name)}
+
+			var v *specValue
+			switch {
+			case na.String != nil:
+				at.Type, v = "string", na.String
+			case na.Int64 != nil:
+				at.Type, v = "int64", na.Int64
+			case na.Bool != nil:
+				at.Type, v = "bool", na.Bool
+			case na.List != nil && na.List.ElementType != nil && na.List.ElementType.String != nil:
+				at.Type, v = "list", na.List
+			default:
+				errs = append(errs, fmt.Sprintf("%s.%s.%s: unsupported attribute type", kind, a.Name, na.Name))
+				continue
+			}
+
+			at.Computed = v.ComputedOptionalRequired == "computed"
+
+			if !slices.Contains(m.Unsupported, na.Name) {
+				name := na.Name
+				if n, ok := m.Fields[na.Name]; ok {
+					name = n
+				}
+
+				lookup := func(fields map[string]sdkField, typ string) *sdkField {
+					f, ok := fields[name]
+					if !ok {
+						errs = append(errs, fmt.Sprintf("%s.%s.%s: %s has no field %q", kind, a.Name, na.Name, typ, name))
+						return nil
+					}
+
+					if !compatible(at.Type, f.Type) {
+						errs = append(errs, fmt.Sprintf("%s.%s.%s: cannot convert %s to %s.%s of type %s", kind, a.Name, na.Name, at.Type, typ, f.Name, f.Type))
+						return nil
+					}
+
+					return &f
+				}
+
+				if !at.Computed {
+					at.Input = lookup(input, m.Config+"Input")
+				}
+				if !slices.Contains(m.WriteOnly, na.Name) {
+					at.Output = lookup(output, m.Config)
+				}
+
+				// The SDK reads an unset field of a non-pointer type back as its
+				// zero value, which Terraform only accepts for computed attributes
+				if at.Output != nil && v.ComputedOptionalRequired == "optional" && !strings.HasPrefix(at.Output.Type, "*") && at.Output.Type != "[]string" {
+					errs = append(errs, fmt.Sprintf("%s.%s.%s: optional, but %s.%s of type %s reads back its zero value when unset", kind, a.Name, na.Name, m.Config, at.Output.Name, at.Output.Type))
+				}
+			}
+
+			c.Attrs = append(c.Attrs, at)
+		}
+
+		connectors = append(connectors, c)
+	}
+
+	// Raw blocks are optional, as only the resources have them
+	for block, m := range blocks {
+		if !seen[block] && !m.Raw {
+			errs = append(errs, fmt.Sprintf("%s.%s: mapped but not in the specification", kind, block))
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("resolving %s connectors:\n\t%s", kind, strings.Join(errs, "\n\t"))
+	}
+
+	return connectors, nil
+}
+
+func compatible(attrType, fieldType string) bool {
+	switch attrType {
+	case "string":
+		return fieldType == "string" || fieldType == "*string"
+	case "int64":
+		return fieldType == "int" || fieldType == "*int"
+	case "bool":
+		return fieldType == "bool" || fieldType == "*bool"
+	case "list":
+		return fieldType == "[]string"
+	}
+
+	return false
+}
+
+// goName returns the Go name the framework code generator uses for a
+// schema name.
+func goName(name string) string {
+	parts := strings.Split(name, "_")
+	for i, p := range parts {
+		if p != "" {
+			parts[i] = strings.ToUpper(p[:1]) + p[1:]
+		}
+	}
+
+	return strings.Join(parts, "")
+}
+
+// toInput returns the expression converting the attribute of block to its
+// SDK input field.
+func toInput(block string, a attribute) string {
+	v := "model." + block + "." + a.Field
+
+	switch a.Input.Type {
+	case "string":
+		return v + ".ValueString()"
+	case "*string":
+		return "convert.StringPointer(" + v + ")"
+	case "bool":
+		return v + ".ValueBool()"
+	case "*bool":
+		return "convert.BoolPointer(" + v + ")"
+	case "int":
+		return "convert.Int(" + v + ")"
+	case "*int":
+		return "convert.IntPointer(" + v + ")"
+	case "[]string":
+		return "convert.Strings(ctx, " + v + ", &diags)"
+	}
+
+	panic("unsupported SDK field type " + a.Input.Type)
+}
+
+// merge returns the connectors of both a and b, with the attributes of both.
+func merge(a, b []connector) []connector {
+	merged := slices.Clone(a)
+
+	for _, c := range b {
+		i := slices.IndexFunc(merged, func(m connector) bool { return m.Block == c.Block })
+		if i < 0 {
+			merged = append(merged, c)
+			continue
+		}
+
+		for _, at := range c.Attrs {
+			if !slices.ContainsFunc(merged[i].Attrs, func(m attribute) bool { return m.Name == at.Name }) {
+				merged[i].Attrs = append(merged[i].Attrs, at)
+			}
+		}
+	}
+
+	return merged
+}
+
+// generator accumulates a Go source file.
+type generator struct {
+	bytes.Buffer
+}
+
+func (g *generator) p(format string, args ...any) {
+	fmt.Fprintf(g, format, args...)
+	g.WriteByte('\n')
+}
+
+func (g *generator) write(path string) error {
+	src, err := format.Source(g.Bytes())
+	if err != nil {
+		return fmt.Errorf("formatting %s: %w\n%s", path, err, g.Bytes())
+	}
+
+	return os.WriteFile(path, src, 0o644)
+}
+
+// writeInputs writes the conversions from the connector blocks of a resource
+// model to SDK config inputs.
+func writeInputs(path, kind string, connectors []connector) error {
+	name := goName(kind)
+
+	var g generator
+	g.p("// Code generated by genconverters. DO NOT EDIT.")
+	g.p("")
+	g.p("package resource_%s", kind)
+	g.p("")
+	g.p("import (")
+	g.p(`"context"`)
+	g.p("")
+	g.p(`"github.com/aws-gopher/terraform-provider-unstructured/internal/convert"`)
+	g.p(`"github.com/aws-gopher/unstructured-sdk-go"`)
+	g.p(`"github.com/hashicorp/terraform-plugin-framework/diag"`)
+	g.p(")")
+	g.p("")
+
+	g.p("// %sConnectors lists the connector blocks of %sModel.", name, name)
+	g.p("var %sConnectors = []string{", name)
+	for _, c := range connectors {
+		g.p("%q,", c.Block)
+	}
+	g.p("}")
+	g.p("")
+
+	g.p("// %sConnectorBlocks returns the names of the connector blocks set in model.", name)
+	g.p("func %sConnectorBlocks(model *%sModel) []string {", name, name)
+	g.p("var blocks []string")
+	for _, c := range connectors {
+		g.p("if !model.%s.IsNull() {", c.Field)
+		g.p("blocks = append(blocks, %q)", c.Block)
+		g.p("}")
+	}
+	g.p("return blocks")
+	g.p("}")
+	g.p("")
+
+	g.p("// %sConfigInput converts the first connector block set in model to an API", name)
+	g.p("// config input. It returns nil when no connector block is set.")
+	g.p("func %sConfigInput(ctx context.Context, model *%sModel) (unstructured.%sConfigInput, diag.Diagnostics) {", name, name, name)
+	g.p("var diags diag.Diagnostics")
+	g.p("")
+	g.p("switch {")
+	for _, c := range connectors {
+		g.p("case !model.%s.IsNull():", c.Field)
+		g.p("config := &unstructured.%sInput{", c.Config)
+		for _, a := range c.Attrs {
+			if a.Input != nil {
+				g.p("%s: %s,", a.Input.Name, toInput(c.Field, a))
+			}
+		}
+		g.p("}")
+		g.p("return config, diags")
+	}
+	g.p("}")
+	g.p("")
+	g.p("return nil, diags")
+	g.p("}")
+	g.p("")
+
+	g.p("// Keep%sUnstoredAttributes sets the connector attributes of model that the API", name)
+	g.p("// does not return to their values in prior, when both set the same block.")
+	g.p("func Keep%sUnstoredAttributes(model, prior *%sModel) {", name, name)
+	for _, c := range connectors {
+		var unstored []attribute
+		for _, a := range c.Attrs {
+			if a.Output == nil && !a.Computed {
+				unstored = append(unstored, a)
+			}
+		}
+
+		if len(unstored) == 0 {
+			continue
+		}
+
+		g.p("if !model.%[1]s.IsNull() && !model.%[1]s.IsUnknown() && !prior.%[1]s.IsNull() && !prior.%[1]s.IsUnknown() {", c.Field)
+		for _, a := range unstored {
+			g.p("model.%[1]s.%[2]s = prior.%[1]s.%[2]s", c.Field, a.Field)
+		}
+		g.p("}")
+	}
+	g.p("}")
+
+	return g.write(path)
+}
+
+// writeCanonical writes the conversions from SDK connector configs to their
+// canonical form, keyed by kind.
+func writeCanonical(path string, connectors map[string][]connector) error {
+	var g generator
+	g.p("// Code generated by genconverters. DO NOT EDIT.")
+	g.p("")
+	g.p("package convert")
+	g.p("")
+	g.p(`import "github.com/aws-gopher/unstructured-sdk-go"`)
+
+	for _, kind := range []string{"source", "destination"} {
+		name := goName(kind)
+
+		g.p("")
+		g.p("// %sConnector returns the connector block name and canonical form of config,", kind)
+		g.p("// or false if config is not a supported connector.")
+		g.p("func %sConnector(config unstructured.%sConfig) (string, Object, bool) {", kind, name)
+		g.p("switch config := config.(type) {")
+		for _, c := range connectors[kind] {
+			g.p("case *unstructured.%s:", c.Config)
+			g.p("return %q, Object{", c.Block)
+			for _, a := range c.Attrs {
+				if a.Output != nil {
+					g.p("%q: config.%s,", a.Name, a.Output.Name)
+				}
+			}
+			g.p("}, true")
+		}
+		g.p("}")
+		g.p("")
+		g.p(`return "", nil, false`)
+		g.p("}")
+
+		g.p("")
+		g.p("// %sConnectorTypes maps connector block names to their API connector types.", kind)
+		g.p("var %sConnectorTypes = map[string]string{", kind)
+		for _, c := range connectors[kind] {
+			g.p("%q: unstructured.%sInput{}.Type(),", c.Block, c.Config)
+		}
+		g.p("}")
+	}
+
+	return g.write(path)
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/aws-gopher/unstructured-sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// client is the subset of the Unstructured API that resources and data
+// sources use. The provider passes an *endpointClient through ResourceData
+// and DataSourceData; tests can pass an in-memory fake instead.
+type client interface {
+	ListSources(ctx context.Context, typ string) ([]unstructured.Source, error)
+	CreateSource(ctx context.Context, in unstructured.CreateSourceRequest) (*unstructured.Source, error)
+	GetSource(ctx context.Context, id string) (*unstructured.Source, error)
+	UpdateSource(ctx context.Context, in unstructured.UpdateSourceRequest) (*unstructured.Source, error)
+	DeleteSource(ctx context.Context, id string) error
+	CreateSourceConnectionCheck(ctx context.Context, id string) (*unstructured.DagNodeConnectionCheck, error)
+	GetSourceConnectionCheck(ctx context.Context, id string) (*unstructured.DagNodeConnectionCheck, error)
+
+	ListDestinations(ctx context.Context, typ string) ([]unstructured.Destination, error)
+	CreateDestination(ctx context.Context, in unstructured.CreateDestinationRequest) (*unstructured.Destination, error)
+	GetDestination(ctx context.Context, id string) (*unstructured.Destination, error)
+	UpdateDestination(ctx context.Context, in unstructured.UpdateDestinationRequest) (*unstructured.Destination, error)
+	DeleteDestination(ctx context.Context, id string) error
+	CreateDestinationConnectionCheck(ctx context.Context, id string) (*unstructured.DagNodeConnectionCheck, error)
+	GetDestinationConnectionCheck(ctx context.Context, id string) (*unstructured.DagNodeConnectionCheck, error)
+
+	ListWorkflows(ctx context.Context, in *unstructured.ListWorkflowsRequest) ([]unstructured.Workflow, error)
+	CreateWorkflow(ctx context.Context, in *unstructured.CreateWorkflowRequest) (*unstructured.Workflow, error)
+	GetWorkflow(ctx context.Context, id string) (*unstructured.Workflow, error)
+	UpdateWorkflow(ctx context.Context, in unstructured.UpdateWorkflowRequest) (*unstructured.Workflow, error)
+	DeleteWorkflow(ctx context.Context, id string) error
+}
+
+var _ client = (*unstructured.Client)(nil)
+
+// endpointClient is a client together with the endpoint it calls, which
+// resource identities record, and the provider settings resources default to.
+type endpointClient struct {
+	client
+	endpoint string
+
+	// deletionProtection is the default of the deletion_protection resource
+	// attributes.
+	deletionProtection bool
+}
+
+// clientEndpoint returns the endpoint c calls, or null when it is not known.
+func clientEndpoint(c client) types.String {
+	if c, ok := c.(*endpointClient); ok {
+		return types.StringValue(c.endpoint)
+	}
+
+	return types.StringNull()
+}
+
+// clientDeletionProtection returns the default deletion protection of the
+// provider that configured c, which is off when it is not known.
+func clientDeletionProtection(c client) bool {
+	if c, ok := c.(*endpointClient); ok {
+		return c.deletionProtection
+	}
+
+	return false
+}
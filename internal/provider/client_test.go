@@ -0,0 +1,567 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws-gopher/unstructured-sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// fakeClient is an in-memory client for unit-testing resources and data
+// sources without HTTP.
+type fakeClient struct {
+	sources      map[string]*unstructured.Source
+	destinations map[string]*unstructured.Destination
+	workflows    map[string]*unstructured.Workflow
+
+	// err, when set, is returned by every call.
+	err error
+
+	// checks are the connection check results returned by successive
+	// connection check calls, the last of which repeats. Checks succeed
+	// when it is empty.
+	checks      []unstructured.DagNodeConnectionCheck
+	checkCalls  int
+	lastCheckID string
+
+	lastSourceConfig      unstructured.SourceConfigInput
+	lastDestinationConfig unstructured.DestinationConfigInput
+	lastWorkflowRequest   any
+
+	listWorkflowsCalls int
+
+	// ids is the last ID number given out for each kind of object.
+	ids map[string]int
+}
+
+var _ client = (*fakeClient)(nil)
+
+func newFakeClient() *fakeClient {
+	return &fakeClient{
+		sources:      map[string]*unstructured.Source{},
+		destinations: map[string]*unstructured.Destination{},
+		workflows:    map[string]*unstructured.Workflow{},
+		ids:          map[string]int{},
+	}
+}
+
+var errFakeNotFound = &unstructured.APIError{Code: http.StatusNotFound, Err: errors.New("not found")}
+
+var fakeTime = time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+// fakeID returns a new ID for an object of the given kind. IDs are never
+// reused, even after their object is deleted, and skip those of objects a
+// test added directly.
+func fakeID[T any](c *fakeClient, kind string, objects map[string]T) string {
+	for {
+		c.ids[kind]++
+
+		id := fmt.Sprintf("%s-%d", kind, c.ids[kind])
+		if _, ok := objects[id]; !ok {
+			return id
+		}
+	}
+}
+
+func (c *fakeClient) call() error {
+	return c.err
+}
+
+// fakeConnector builds the API representation of a connector from its
+// config input the same way the API does: by decoding the config as the
+// response type.
+func fakeConnector(id, name, typ string, config any, out any) error {
+	b, err := json.Marshal(map[string]any{
+		"id":         id,
+		"name":       name,
+		"type":       typ,
+		"config":     config,
+		"created_at": fakeTime,
+		"updated_at": fakeTime,
+	})
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, out)
+}
+
+func (c *fakeClient) ListSources(_ context.Context, typ string) ([]unstructured.Source, error) {
+	if err := c.call(); err != nil {
+		return nil, err
+	}
+
+	var out []unstructured.Source
+	for _, id := range slices.Sorted(maps.Keys(c.sources)) {
+		if typ == "" || c.sources[id].Type == typ {
+			out = append(out, *c.sources[id])
+		}
+	}
+
+	return out, nil
+}
+
+func (c *fakeClient) CreateSource(_ context.Context, in unstructured.CreateSourceRequest) (*unstructured.Source, error) {
+	if err := c.call(); err != nil {
+		return nil, err
+	}
+
+	c.lastSourceConfig = in.Config
+
+	source := &unstructured.Source{}
+	id := fakeID(c, "source", c.sources)
+	if err := fakeConnector(id, in.Name, in.Config.Type(), in.Config, source); err != nil {
+		return nil, err
+	}
+
+	c.sources[id] = source
+
+	return source, nil
+}
+
+func (c *fakeClient) GetSource(_ context.Context, id string) (*unstructured.Source, error) {
+	if err := c.call(); err != nil {
+		return nil, err
+	}
+
+	source, ok := c.sources[id]
+	if !ok {
+		return nil, errFakeNotFound
+	}
+
+	return source, nil
+}
+
+func (c *fakeClient) UpdateSource(_ context.Context, in unstructured.UpdateSourceRequest) (*unstructured.Source, error) {
+	if err := c.call(); err != nil {
+		return nil, err
+	}
+
+	c.lastSourceConfig = in.Config
+
+	existing, ok := c.sources[in.ID]
+	if !ok {
+		return nil, errFakeNotFound
+	}
+
+	source := &unstructured.Source{}
+	if err := fakeConnector(in.ID, existing.Name, in.Config.Type(), in.Config, source); err != nil {
+		return nil, err
+	}
+
+	c.sources[in.ID] = source
+
+	return source, nil
+}
+
+func (c *fakeClient) DeleteSource(_ context.Context, id string) error {
+	if err := c.call(); err != nil {
+		return err
+	}
+
+	if _, ok := c.sources[id]; !ok {
+		return errFakeNotFound
+	}
+
+	delete(c.sources, id)
+
+	return nil
+}
+
+func (c *fakeClient) CreateSourceConnectionCheck(_ context.Context, id string) (*unstructured.DagNodeConnectionCheck, error) {
+	if _, ok := c.sources[id]; !ok {
+		return nil, errFakeNotFound
+	}
+
+	return c.connectionCheck(id)
+}
+
+func (c *fakeClient) GetSourceConnectionCheck(_ context.Context, id string) (*unstructured.DagNodeConnectionCheck, error) {
+	return c.connectionCheck(id)
+}
+
+func (c *fakeClient) ListDestinations(_ context.Context, typ string) ([]unstructured.Destination, error) {
+	if err := c.call(); err != nil {
+		return nil, err
+	}
+
+	var out []unstructured.Destination
+	for _, id := range slices.Sorted(maps.Keys(c.destinations)) {
+		if typ == "" || c.destinations[id].Type == typ {
+			out = append(out, *c.destinations[id])
+		}
+	}
+
+	return out, nil
+}
+
+func (c *fakeClient) CreateDestination(_ context.Context, in unstructured.CreateDestinationRequest) (*unstructured.Destination, error) {
+	if err := c.call(); err != nil {
+		return nil, err
+	}
+
+	c.lastDestinationConfig = in.Config
+
+	destination := &unstructured.Destination{}
+	id := fakeID(c, "destination", c.destinations)
+	if err := fakeConnector(id, in.Name, in.Config.Type(), in.Config, destination); err != nil {
+		return nil, err
+	}
+
+	c.destinations[id] = destination
+
+	return destination, nil
+}
+
+func (c *fakeClient) GetDestination(_ context.Context, id string) (*unstructured.Destination, error) {
+	if err := c.call(); err != nil {
+		return nil, err
+	}
+
+	destination, ok := c.destinations[id]
+	if !ok {
+		return nil, errFakeNotFound
+	}
+
+	return destination, nil
+}
+
+func (c *fakeClient) UpdateDestination(_ context.Context, in unstructured.UpdateDestinationRequest) (*unstructured.Destination, error) {
+	if err := c.call(); err != nil {
+		return nil, err
+	}
+
+	c.lastDestinationConfig = in.Config
+
+	existing, ok := c.destinations[in.ID]
+	if !ok {
+		return nil, errFakeNotFound
+	}
+
+	destination := &unstructured.Destination{}
+	if err := fakeConnector(in.ID, existing.Name, in.Config.Type(), in.Config, destination); err != nil {
+		return nil, err
+	}
+
+	c.destinations[in.ID] = destination
+
+	return destination, nil
+}
+
+func (c *fakeClient) DeleteDestination(_ context.Context, id string) error {
+	if err := c.call(); err != nil {
+		return err
+	}
+
+	if _, ok := c.destinations[id]; !ok {
+		return errFakeNotFound
+	}
+
+	delete(c.destinations, id)
+
+	return nil
+}
+
+func (c *fakeClient) CreateDestinationConnectionCheck(_ context.Context, id string) (*unstructured.DagNodeConnectionCheck, error) {
+	if _, ok := c.destinations[id]; !ok {
+		return nil, errFakeNotFound
+	}
+
+	return c.connectionCheck(id)
+}
+
+func (c *fakeClient) GetDestinationConnectionCheck(_ context.Context, id string) (*unstructured.DagNodeConnectionCheck, error) {
+	return c.connectionCheck(id)
+}
+
+// connectionCheck returns the next result of c.checks.
+func (c *fakeClient) connectionCheck(id string) (*unstructured.DagNodeConnectionCheck, error) {
+	if err := c.call(); err != nil {
+		return nil, err
+	}
+
+	c.lastCheckID = id
+	c.checkCalls++
+
+	if len(c.checks) == 0 {
+		return &unstructured.DagNodeConnectionCheck{ID: "check-1", Status: unstructured.ConnectionCheckStatusSuccess}, nil
+	}
+
+	check := c.checks[min(c.checkCalls, len(c.checks))-1]
+
+	return &check, nil
+}
+
+// ListWorkflows filters and paginates workflows in ID order, as the API does
+// in creation order.
+func (c *fakeClient) ListWorkflows(_ context.Context, in *unstructured.ListWorkflowsRequest) ([]unstructured.Workflow, error) {
+	if err := c.call(); err != nil {
+		return nil, err
+	}
+
+	c.listWorkflowsCalls++
+
+	var out []unstructured.Workflow
+	for _, id := range slices.Sorted(maps.Keys(c.workflows)) {
+		workflow := c.workflows[id]
+
+		switch {
+		case in.SourceID != nil && !slices.Contains(workflow.Sources, *in.SourceID):
+		case in.DestinationID != nil && !slices.Contains(workflow.Destinations, *in.DestinationID):
+		case in.Status != nil && workflow.Status != *in.Status:
+		case in.Name != nil && !strings.Contains(workflow.Name, *in.Name):
+		default:
+			out = append(out, *workflow)
+		}
+	}
+
+	if in.Page != nil && in.PageSize != nil {
+		start := min((*in.Page-1)**in.PageSize, len(out))
+		out = out[start:min(start+*in.PageSize, len(out))]
+	}
+
+	return out, nil
+}
+
+func (c *fakeClient) CreateWorkflow(_ context.Context, in *unstructured.CreateWorkflowRequest) (*unstructured.Workflow, error) {
+	if err := c.call(); err != nil {
+		return nil, err
+	}
+
+	c.lastWorkflowRequest = in
+
+	workflow := &unstructured.Workflow{
+		ID:            fakeID(c, "workflow", c.workflows),
+		Name:          in.Name,
+		WorkflowType:  &in.WorkflowType,
+		WorkflowNodes: in.WorkflowNodes,
+		Status:        unstructured.WorkflowStateActive,
+		CreatedAt:     fakeTime,
+		UpdatedAt:     fakeTime,
+		ReprocessAll:  in.ReprocessAll,
+	}
+
+	fakeWorkflowRefs(workflow, in.SourceID, in.DestinationID, in.Schedule)
+	c.workflows[workflow.ID] = workflow
+
+	return workflow, nil
+}
+
+func (c *fakeClient) GetWorkflow(_ context.Context, id string) (*unstructured.Workflow, error) {
+	if err := c.call(); err != nil {
+		return nil, err
+	}
+
+	workflow, ok := c.workflows[id]
+	if !ok {
+		return nil, errFakeNotFound
+	}
+
+	return workflow, nil
+}
+
+func (c *fakeClient) UpdateWorkflow(_ context.Context, in unstructured.UpdateWorkflowRequest) (*unstructured.Workflow, error) {
+	if err := c.call(); err != nil {
+		return nil, err
+	}
+
+	c.lastWorkflowRequest = in
+
+	existing, ok := c.workflows[in.ID]
+	if !ok {
+		return nil, errFakeNotFound
+	}
+
+	workflow := *existing
+	if in.Name != nil {
+		workflow.Name = *in.Name
+	}
+
+	if in.WorkflowType != nil {
+		workflow.WorkflowType = in.WorkflowType
+	}
+
+	if in.ReprocessAll != nil {
+		workflow.ReprocessAll = in.ReprocessAll
+	}
+
+	workflow.WorkflowNodes = in.WorkflowNodes
+	fakeWorkflowRefs(&workflow, in.SourceID, in.DestinationID, in.Schedule)
+	c.workflows[in.ID] = &workflow
+
+	return &workflow, nil
+}
+
+func (c *fakeClient) DeleteWorkflow(_ context.Context, id string) error {
+	if err := c.call(); err != nil {
+		return err
+	}
+
+	if _, ok := c.workflows[id]; !ok {
+		return errFakeNotFound
+	}
+
+	delete(c.workflows, id)
+
+	return nil
+}
+
+func fakeWorkflowRefs(workflow *unstructured.Workflow, sourceID, destinationID, schedule *string) {
+	workflow.Sources, workflow.Destinations, workflow.Schedule = nil, nil, nil
+
+	if sourceID != nil {
+		workflow.Sources = []string{*sourceID}
+	}
+
+	if destinationID != nil {
+		workflow.Destinations = []string{*destinationID}
+	}
+
+	if schedule != nil {
+		workflow.Schedule = &unstructured.WorkflowSchedule{
+			CronTabEntries: []unstructured.CronTabEntry{{CronExpression: *schedule}},
+		}
+	}
+}
+
+// testResource configures r with c and returns an empty state of its schema.
+func testResource(t *testing.T, r resource.Resource, c client) tfsdk.State {
+	t.Helper()
+
+	if r, ok := r.(resource.ResourceWithConfigure); ok {
+		var resp resource.ConfigureResponse
+		r.Configure(t.Context(), resource.ConfigureRequest{ProviderData: c}, &resp)
+
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("Configure() diagnostics = %v", resp.Diagnostics)
+		}
+	}
+
+	var resp resource.SchemaResponse
+	r.Schema(t.Context(), resource.SchemaRequest{}, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Schema() diagnostics = %v", resp.Diagnostics)
+	}
+
+	return tfsdk.State{
+		Schema: resp.Schema,
+		Raw:    tftypes.NewValue(resp.Schema.Type().TerraformType(t.Context()), nil),
+	}
+}
+
+// testState returns a state of the given schema holding model, or a null
+// state when model is nil.
+func testState(t *testing.T, empty tfsdk.State, model any) tfsdk.State {
+	t.Helper()
+
+	state := empty
+	if model == nil {
+		return state
+	}
+
+	if diags := state.Set(t.Context(), model); diags.HasError() {
+		t.Fatalf("State.Set() diagnostics = %v", diags)
+	}
+
+	return state
+}
+
+// testCRUD runs a single CRUD method of r with the given prior state and
+// plan models, either of which may be nil, and returns the resulting state.
+func testCRUD(t *testing.T, r resource.Resource, empty tfsdk.State, op string, prior, planned any) (tfsdk.State, diag.Diagnostics) {
+	t.Helper()
+
+	ctx := t.Context()
+	priorState := testState(t, empty, prior)
+	plannedState := testState(t, empty, planned)
+	plan := tfsdk.Plan{Schema: plannedState.Schema, Raw: plannedState.Raw}
+
+	switch op {
+	case "Create":
+		resp := resource.CreateResponse{State: empty}
+		r.Create(ctx, resource.CreateRequest{Plan: plan}, &resp)
+		return resp.State, resp.Diagnostics
+
+	case "Read":
+		resp := resource.ReadResponse{State: priorState}
+		r.Read(ctx, resource.ReadRequest{State: priorState}, &resp)
+		return resp.State, resp.Diagnostics
+
+	case "Update":
+		resp := resource.UpdateResponse{State: priorState}
+		r.Update(ctx, resource.UpdateRequest{Plan: plan, State: priorState}, &resp)
+		return resp.State, resp.Diagnostics
+
+	case "Delete":
+		resp := resource.DeleteResponse{State: priorState}
+		r.Delete(ctx, resource.DeleteRequest{State: priorState}, &resp)
+		return resp.State, resp.Diagnostics
+	}
+
+	t.Fatalf("unknown operation %q", op)
+
+	return empty, nil
+}
+
+// testDataSourceRead configures d with c and reads it with only the id
+// attribute configured.
+func testDataSourceRead(t *testing.T, d datasource.DataSource, c client, id string) (tfsdk.State, diag.Diagnostics) {
+	t.Helper()
+
+	return testDataSourceReadConfig(t, d, c, map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.String, id),
+	})
+}
+
+// testDataSourceReadConfig configures d with c and reads it with the given
+// attributes configured and every other attribute null.
+func testDataSourceReadConfig(t *testing.T, d datasource.DataSource, c client, config map[string]tftypes.Value) (tfsdk.State, diag.Diagnostics) {
+	t.Helper()
+
+	if d, ok := d.(datasource.DataSourceWithConfigure); ok {
+		var resp datasource.ConfigureResponse
+		d.Configure(t.Context(), datasource.ConfigureRequest{ProviderData: c}, &resp)
+
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("Configure() diagnostics = %v", resp.Diagnostics)
+		}
+	}
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(t.Context(), datasource.SchemaRequest{}, &schemaResp)
+
+	typ, ok := schemaResp.Schema.Type().TerraformType(t.Context()).(tftypes.Object)
+	if !ok {
+		t.Fatal("data source schema is not an object")
+	}
+
+	attrs := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+	for name, attrType := range typ.AttributeTypes {
+		attrs[name] = tftypes.NewValue(attrType, nil)
+		if v, ok := config[name]; ok {
+			attrs[name] = v
+		}
+	}
+
+	resp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(typ, nil)}}
+	d.Read(t.Context(), datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(typ, attrs)}}, &resp)
+
+	return resp.State, resp.Diagnostics
+}
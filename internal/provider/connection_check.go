@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws-gopher/unstructured-sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// connectionCheckInterval is how long to wait between polls of a scheduled
+// connection check.
+var connectionCheckInterval = 2 * time.Second
+
+// connectionCheckFunc starts or reads the connection check of a connector.
+type connectionCheckFunc func(ctx context.Context, id string) (*unstructured.DagNodeConnectionCheck, error)
+
+// checkConnection starts a connection check of the connector with the given
+// ID when enabled, and polls it until it finishes. A failed check is reported
+// as an error, or as a warning when mode is "warn".
+func checkConnection(ctx context.Context, kind, id string, enabled types.Bool, mode types.String, start, get connectionCheckFunc) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !enabled.ValueBool() {
+		return diags
+	}
+
+	check, err := start(ctx, id)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error checking %s connection", kind), err.Error())
+		return diags
+	}
+
+	for check.Status != unstructured.ConnectionCheckStatusSuccess && check.Status != unstructured.ConnectionCheckStatusFailure {
+		select {
+		case <-ctx.Done():
+			diags.AddError(fmt.Sprintf("Error checking %s connection", kind), fmt.Sprintf("waiting for the connection check of %s %s: %s", kind, id, ctx.Err()))
+			return diags
+		case <-time.After(connectionCheckInterval):
+		}
+
+		check, err = get(ctx, id)
+		if err != nil {
+			diags.AddError(fmt.Sprintf("Error checking %s connection", kind), err.Error())
+			return diags
+		}
+	}
+
+	if check.Status == unstructured.ConnectionCheckStatusSuccess {
+		return diags
+	}
+
+	reason := "no reason was given"
+	if check.Reason != nil && *check.Reason != "" {
+		reason = *check.Reason
+	}
+
+	summary := fmt.Sprintf("Connection check of %s failed", kind)
+	detail := fmt.Sprintf("The %s %s could not connect: %s", kind, id, reason)
+
+	if mode.ValueString() == "warn" {
+		diags.AddWarning(summary, detail)
+	} else {
+		diags.AddError(summary, detail)
+	}
+
+	return diags
+}
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/resource_destination"
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/resource_source"
+	"github.com/aws-gopher/unstructured-sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestConnectionCheck(t *testing.T) {
+	interval := connectionCheckInterval
+	connectionCheckInterval = time.Millisecond
+	t.Cleanup(func() { connectionCheckInterval = interval })
+
+	reason := "bucket does not exist"
+	scheduled := unstructured.DagNodeConnectionCheck{ID: "check-1", Status: unstructured.ConnectionCheckStatusScheduled}
+	success := unstructured.DagNodeConnectionCheck{ID: "check-1", Status: unstructured.ConnectionCheckStatusSuccess}
+	failure := unstructured.DagNodeConnectionCheck{ID: "check-1", Status: unstructured.ConnectionCheckStatusFailure, Reason: &reason}
+
+	tests := []struct {
+		name        string
+		destination bool
+		op          string
+		check       types.Bool
+		mode        types.String
+		checks      []unstructured.DagNodeConnectionCheck
+		wantCalls   int
+		wantErr     string
+		wantWarning string
+	}{
+		{name: "disabled", op: "Create", check: types.BoolNull(), checks: []unstructured.DagNodeConnectionCheck{failure}},
+		{name: "disabled explicitly", op: "Create", check: types.BoolValue(false), checks: []unstructured.DagNodeConnectionCheck{failure}},
+		{name: "success after polling", op: "Create", check: types.BoolValue(true), checks: []unstructured.DagNodeConnectionCheck{scheduled, scheduled, success}, wantCalls: 3},
+		{name: "failure", op: "Create", check: types.BoolValue(true), checks: []unstructured.DagNodeConnectionCheck{scheduled, failure}, wantCalls: 2, wantErr: "could not connect: bucket does not exist"},
+		{name: "failure in error mode", op: "Update", check: types.BoolValue(true), mode: types.StringValue("error"), checks: []unstructured.DagNodeConnectionCheck{failure}, wantCalls: 1, wantErr: "Connection check of source failed"},
+		{name: "failure in warn mode", op: "Update", check: types.BoolValue(true), mode: types.StringValue("warn"), checks: []unstructured.DagNodeConnectionCheck{failure}, wantCalls: 1, wantWarning: "could not connect: bucket does not exist"},
+		{name: "destination failure", destination: true, op: "Create", check: types.BoolValue(true), checks: []unstructured.DagNodeConnectionCheck{failure}, wantCalls: 1, wantErr: "Connection check of destination failed"},
+		{name: "destination success", destination: true, op: "Update", check: types.BoolValue(true), checks: []unstructured.DagNodeConnectionCheck{scheduled, success}, wantCalls: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newFakeClient()
+			c.checks = tt.checks
+
+			var prior, planned any
+
+			if tt.destination {
+				c.destinations["destination-1"] = &unstructured.Destination{ID: "destination-1", Name: "existing", Type: unstructured.ConnectorTypeS3, Config: &unstructured.S3DestinationConnectorConfig{RemoteURL: "s3://existing/"}}
+
+				model := testDestinationModel(t, "", "existing", "s3://bucket/")
+				if tt.op == "Update" {
+					prior = testDestinationModel(t, "destination-1", "existing", "s3://existing/")
+					model.Id = types.StringValue("destination-1")
+				}
+				model.CheckConnection, model.CheckConnectionMode = tt.check, tt.mode
+				planned = model
+			} else {
+				c.sources["source-1"] = &unstructured.Source{ID: "source-1", Name: "existing", Type: unstructured.ConnectorTypeS3, Config: &unstructured.S3SourceConnectorConfig{RemoteURL: "s3://existing/"}}
+
+				model := testSourceModel(t, "", "existing", "s3://bucket/")
+				if tt.op == "Update" {
+					prior = testSourceModel(t, "source-1", "existing", "s3://existing/")
+					model.Id = types.StringValue("source-1")
+				}
+				model.CheckConnection, model.CheckConnectionMode = tt.check, tt.mode
+				planned = model
+			}
+
+			r := NewSourceResource()
+			if tt.destination {
+				r = NewDestinationResource()
+			}
+
+			empty := testResource(t, r, c)
+			got, diags := testCRUD(t, r, empty, tt.op, prior, planned)
+
+			if tt.wantErr == "" && diags.HasError() {
+				t.Fatalf("%s() diagnostics = %v", tt.op, diags)
+			}
+
+			if !strings.Contains(fmt.Sprint(diags.Errors()), tt.wantErr) || !strings.Contains(fmt.Sprint(diags.Warnings()), tt.wantWarning) {
+				t.Fatalf("%s() diagnostics = %v, want error %q and warning %q", tt.op, diags, tt.wantErr, tt.wantWarning)
+			}
+
+			if c.checkCalls != tt.wantCalls {
+				t.Errorf("connection check calls = %d, want %d", c.checkCalls, tt.wantCalls)
+			}
+
+			// The state is saved even when the check fails, with the
+			// connection check settings from the plan.
+			var id string
+			var check types.Bool
+			if tt.destination {
+				var model resource_destination.DestinationModel
+				diags = got.Get(t.Context(), &model)
+				id, check = model.Id.ValueString(), model.CheckConnection
+			} else {
+				var model resource_source.SourceModel
+				diags = got.Get(t.Context(), &model)
+				id, check = model.Id.ValueString(), model.CheckConnection
+			}
+
+			if diags.HasError() {
+				t.Fatalf("State.Get() diagnostics = %v", diags)
+			}
+
+			if id == "" || !check.Equal(tt.check) {
+				t.Errorf("state id = %q, check_connection = %s, want %s", id, check, tt.check)
+			}
+
+			if tt.wantCalls > 0 && c.lastCheckID != id {
+				t.Errorf("checked connector %q, want %q", c.lastCheckID, id)
+			}
+		})
+	}
+}
@@ -0,0 +1,315 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/resource_destination"
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/resource_source"
+	"github.com/aws-gopher/unstructured-sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// TestSourceConnectorRoundTrip checks, for every connector block in the source
+// schema, that a fully populated block survives conversion to an API input,
+// an API response and back.
+func TestSourceConnectorRoundTrip(t *testing.T) {
+	r := &sourceResource{}
+
+	testConnectorRoundTrip(t, "source", r, func(t *testing.T, plan tfsdk.Plan, read, state *tfsdk.State) {
+		var data resource_source.SourceModel
+		if diags := plan.Get(t.Context(), &data); diags.HasError() {
+			t.Fatalf("Plan.Get() diagnostics = %v", diags)
+		}
+
+		config, diags := r.getSourceConfig(t.Context(), &data)
+		if diags.HasError() {
+			t.Fatalf("getSourceConfig() diagnostics = %v", diags)
+		}
+
+		var source unstructured.Source
+		testConnectorResponse(t, config.Type(), config, &source)
+
+		model, diags := resource_source.SourceToModel(t.Context(), &source)
+		if diags.HasError() {
+			t.Fatalf("SourceToModel() diagnostics = %v", diags)
+		}
+
+		if diags := read.Set(t.Context(), model); diags.HasError() {
+			t.Fatalf("State.Set() diagnostics = %v", diags)
+		}
+
+		resource_source.KeepSourceUnstoredAttributes(model, &data)
+
+		if diags := state.Set(t.Context(), model); diags.HasError() {
+			t.Fatalf("State.Set() diagnostics = %v", diags)
+		}
+	})
+}
+
+// TestDestinationConnectorRoundTrip is TestSourceConnectorRoundTrip for the
+// destination schema.
+func TestDestinationConnectorRoundTrip(t *testing.T) {
+	r := &destinationResource{}
+
+	testConnectorRoundTrip(t, "destination", r, func(t *testing.T, plan tfsdk.Plan, read, state *tfsdk.State) {
+		var data resource_destination.DestinationModel
+		if diags := plan.Get(t.Context(), &data); diags.HasError() {
+			t.Fatalf("Plan.Get() diagnostics = %v", diags)
+		}
+
+		config, diags := r.getDestinationConfig(t.Context(), &data)
+		if diags.HasError() {
+			t.Fatalf("getDestinationConfig() diagnostics = %v", diags)
+		}
+
+		var destination unstructured.Destination
+		testConnectorResponse(t, config.Type(), config, &destination)
+
+		model, diags := resource_destination.DestinationToModel(t.Context(), &destination)
+		if diags.HasError() {
+			t.Fatalf("DestinationToModel() diagnostics = %v", diags)
+		}
+
+		if diags := read.Set(t.Context(), model); diags.HasError() {
+			t.Fatalf("State.Set() diagnostics = %v", diags)
+		}
+
+		resource_destination.KeepDestinationUnstoredAttributes(model, &data)
+
+		if diags := state.Set(t.Context(), model); diags.HasError() {
+			t.Fatalf("State.Set() diagnostics = %v", diags)
+		}
+	})
+}
+
+// testConnectorResponse simulates the API storing config and returning it,
+// decoding the result into out.
+func testConnectorResponse(t *testing.T, typ string, config, out any) {
+	t.Helper()
+
+	b, err := json.Marshal(map[string]any{
+		"id":     "connector-id",
+		"name":   "connector-name",
+		"type":   typ,
+		"config": config,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := json.Unmarshal(b, out); err != nil {
+		t.Fatalf("decoding the simulated response: %v", err)
+	}
+}
+
+// testConnectorRoundTrip runs roundTrip for each connector block of r's
+// schema, with the block fully populated and with only its required attributes
+// set. roundTrip sets read to the state as read from the API, and state to it
+// with the unstored attributes kept from the plan. The block read from the API
+// is compared with the planned one attribute by attribute, except for the
+// attributes converters.json marks unsupported or write-only for the kind of
+// connector: those must read back null and then be kept.
+func testConnectorRoundTrip(t *testing.T, kind string, r resource.Resource, roundTrip func(t *testing.T, plan tfsdk.Plan, read, state *tfsdk.State)) {
+	var resp resource.SchemaResponse
+	r.Schema(t.Context(), resource.SchemaRequest{}, &resp)
+
+	unstored := testUnstoredAttributes(t, kind)
+
+	typ, ok := resp.Schema.Type().TerraformType(t.Context()).(tftypes.Object)
+	if !ok {
+		t.Fatal("resource schema is not an object")
+	}
+
+	var blocks []string
+	for name, attrType := range typ.AttributeTypes {
+		if _, ok := attrType.(tftypes.Object); ok {
+			blocks = append(blocks, name)
+		}
+	}
+	slices.Sort(blocks)
+
+	for _, block := range blocks {
+		t.Run(block, func(t *testing.T) {
+			if block == "custom" {
+				t.Skip("the custom block holds raw config JSON, see TestCustomConnectorCRUD")
+			}
+
+			blockType, _ := typ.AttributeTypes[block].(tftypes.Object)
+			blockSchema, _ := resp.Schema.Attributes[block].(schema.SingleNestedAttribute)
+
+			for _, tc := range []struct {
+				name string
+				// set reports whether the block sets an attribute.
+				set func(attr schema.Attribute) bool
+			}{
+				{name: "populated", set: func(schema.Attribute) bool { return true }},
+				{name: "required only", set: schema.Attribute.IsRequired},
+			} {
+				t.Run(tc.name, func(t *testing.T) {
+					want := testPopulatedObject(blockType, func(name string) bool { return tc.set(blockSchema.Attributes[name]) })
+
+					attrs := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+					for name, attrType := range typ.AttributeTypes {
+						attrs[name] = tftypes.NewValue(attrType, nil)
+					}
+					attrs["name"] = tftypes.NewValue(tftypes.String, "connector-name")
+					attrs[block] = want
+
+					plan := tfsdk.Plan{Schema: resp.Schema, Raw: tftypes.NewValue(typ, attrs)}
+					read := tfsdk.State{Schema: resp.Schema, Raw: tftypes.NewValue(typ, nil)}
+					state := tfsdk.State{Schema: resp.Schema, Raw: tftypes.NewValue(typ, nil)}
+
+					roundTrip(t, plan, &read, &state)
+
+					var readAttrs, gotAttrs map[string]tftypes.Value
+					if err := read.Raw.As(&readAttrs); err != nil {
+						t.Fatal(err)
+					}
+					if err := state.Raw.As(&gotAttrs); err != nil {
+						t.Fatal(err)
+					}
+
+					// Unset computed attributes may read back as anything.
+					testCompareObjects(t, want, readAttrs[block], func(name string) bool {
+						if slices.Contains(unstored[block], name) {
+							return false
+						}
+
+						return tc.set(blockSchema.Attributes[name]) || !blockSchema.Attributes[name].IsComputed()
+					})
+
+					// The API doesn't return unstored attributes, so they
+					// are kept from the plan.
+					var readBlock map[string]tftypes.Value
+					if err := readAttrs[block].As(&readBlock); err != nil {
+						t.Fatal(err)
+					}
+
+					for _, name := range unstored[block] {
+						// The mapping is shared with the data sources, whose
+						// blocks may have attributes the resource's lack.
+						if _, ok := blockSchema.Attributes[name]; !ok {
+							continue
+						}
+
+						if !readBlock[name].IsNull() {
+							t.Errorf("%s = %s as read from the API, want null", name, readBlock[name])
+						}
+					}
+
+					testCompareObjects(t, want, gotAttrs[block], func(name string) bool {
+						return slices.Contains(unstored[block], name)
+					})
+				})
+			}
+		})
+	}
+}
+
+// testUnstoredAttributes returns the attributes that converters.json marks
+// unsupported or write-only for each connector block of kind.
+func testUnstoredAttributes(t *testing.T, kind string) map[string][]string {
+	t.Helper()
+
+	b, err := os.ReadFile(filepath.Join("..", "..", "converters.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var mapping map[string]map[string]struct {
+		Unsupported []string `json:"unsupported"`
+		WriteOnly   []string `json:"write_only"`
+	}
+	if err := json.Unmarshal(b, &mapping); err != nil {
+		t.Fatalf("parsing converters.json: %v", err)
+	}
+
+	unstored := make(map[string][]string, len(mapping[kind]))
+	for block, m := range mapping[kind] {
+		unstored[block] = slices.Concat(m.Unsupported, m.WriteOnly)
+	}
+
+	return unstored
+}
+
+// testPopulatedObject returns a value of typ with every attribute that set
+// reports set to a distinct non-null value, and the others null.
+func testPopulatedObject(typ tftypes.Object, set func(name string) bool) tftypes.Value {
+	names := make([]string, 0, len(typ.AttributeTypes))
+	for name := range typ.AttributeTypes {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	attrs := make(map[string]tftypes.Value, len(names))
+	for i, name := range names {
+		if !set(name) {
+			attrs[name] = tftypes.NewValue(typ.AttributeTypes[name], nil)
+			continue
+		}
+
+		switch attrType := typ.AttributeTypes[name]; {
+		case attrType.Is(tftypes.String):
+			attrs[name] = tftypes.NewValue(tftypes.String, name+"-value")
+		case attrType.Is(tftypes.Number):
+			attrs[name] = tftypes.NewValue(tftypes.Number, big.NewFloat(float64(i+1)))
+		case attrType.Is(tftypes.Bool):
+			attrs[name] = tftypes.NewValue(tftypes.Bool, true)
+		case attrType.Is(tftypes.List{ElementType: tftypes.String}):
+			attrs[name] = tftypes.NewValue(attrType, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, name+"-1"),
+				tftypes.NewValue(tftypes.String, name+"-2"),
+			})
+		default:
+			panic(fmt.Sprintf("unsupported connector attribute type %s for %s", attrType, name))
+		}
+	}
+
+	return tftypes.NewValue(typ, attrs)
+}
+
+// testCompareObjects reports every attribute of got that compare reports and
+// that differs from want.
+func testCompareObjects(t *testing.T, want, got tftypes.Value, compare func(name string) bool) {
+	t.Helper()
+
+	if got.IsNull() {
+		t.Fatalf("block is null after the round trip, want %s", want)
+	}
+
+	var wantAttrs, gotAttrs map[string]tftypes.Value
+	if err := want.As(&wantAttrs); err != nil {
+		t.Fatal(err)
+	}
+	if err := got.As(&gotAttrs); err != nil {
+		t.Fatal(err)
+	}
+
+	names := make([]string, 0, len(wantAttrs))
+	for name := range wantAttrs {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		if !compare(name) {
+			continue
+		}
+
+		if !wantAttrs[name].Equal(gotAttrs[name]) {
+			t.Errorf("%s = %s, want %s", name, gotAttrs[name], wantAttrs[name])
+		}
+	}
+}
@@ -0,0 +1,269 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/convert"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// exactlyOneConnector returns a validator requiring exactly one of the given
+// connector blocks or the custom connector block to be configured.
+func exactlyOneConnector(blocks []string) resource.ConfigValidator {
+	expressions := make([]path.Expression, 0, len(blocks)+1)
+	for _, block := range blocks {
+		expressions = append(expressions, path.MatchRoot(block))
+	}
+	expressions = append(expressions, path.MatchRoot("custom"))
+
+	return resourcevalidator.ExactlyOneOf(expressions...)
+}
+
+// requireConnectorReplace marks the resource for replacement when the plan
+// sets a different connector block than the prior state, as the API cannot
+// change the type of an existing connector. Changes within the same connector
+// block are left to update in place.
+func requireConnectorReplace(kind string, prior, planned []string, resp *resource.ModifyPlanResponse) {
+	if slices.Equal(prior, planned) {
+		return
+	}
+
+	for _, block := range prior {
+		if !slices.Contains(planned, block) {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root(block))
+		}
+	}
+
+	for _, block := range planned {
+		if !slices.Contains(prior, block) {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root(block))
+		}
+	}
+
+	resp.Diagnostics.AddWarning(connectorChangeWarning(kind, connectorNames(prior), connectorNames(planned)))
+}
+
+// withCustomConnector returns blocks with the custom connector block added
+// when it is set.
+func withCustomConnector(blocks []string, custom convert.CustomConnectorValue) []string {
+	if custom.IsNull() {
+		return blocks
+	}
+
+	return append(blocks, "custom")
+}
+
+// requireCustomTypeReplace marks the resource for replacement when the plan
+// changes the type of the custom connector block, which the API cannot do any
+// more than it can change connector blocks.
+func requireCustomTypeReplace(kind string, prior, planned convert.CustomConnectorValue, resp *resource.ModifyPlanResponse) {
+	if prior.IsNull() || planned.IsNull() || planned.IsUnknown() {
+		return
+	}
+
+	priorType, plannedType := prior.ConnectorType(), planned.ConnectorType()
+	if plannedType.IsUnknown() || priorType.Equal(plannedType) {
+		return
+	}
+
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("custom").AtName("type"))
+	resp.Diagnostics.AddWarning(connectorChangeWarning(kind, priorType.ValueString(), plannedType.ValueString()))
+}
+
+// plannedConnectorType returns the connector type of the connector block in
+// blocks, as typeOf names it, or of the custom connector block. It is unknown
+// until exactly one connector block is known to be set.
+func plannedConnectorType(blocks []string, custom convert.CustomConnectorValue, typeOf func(block string) string) types.String {
+	switch {
+	case !custom.IsNull() && !custom.IsUnknown():
+		return custom.ConnectorType()
+	case custom.IsNull() && len(blocks) == 1:
+		return types.StringValue(typeOf(blocks[0]))
+	}
+
+	return types.StringUnknown()
+}
+
+func connectorChangeWarning(kind, prior, planned string) (string, string) {
+	return "Connector type change requires replacement",
+		fmt.Sprintf("The %[1]s changes from the %[2]s connector to the %[3]s connector. "+
+			"The connector type of an existing %[1]s cannot be changed, so Terraform will delete it and create a new %[1]s with a new ID.",
+			kind, prior, planned)
+}
+
+func connectorNames(blocks []string) string {
+	if len(blocks) == 0 {
+		return "no"
+	}
+
+	return strings.Join(blocks, ", ")
+}
+
+// connectorValidators holds validators for connector attributes, which the
+// generated schemas do not have. Keys are "<block>.<attribute>", where either
+// part may be "*" to match any block or attribute.
+type connectorValidators struct {
+	strings map[string][]validator.String
+	int64s  map[string][]validator.Int64
+	lists   map[string][]validator.List
+}
+
+// connectorAttributeValidators applies to the connector blocks of both the
+// source and the destination resources.
+var connectorAttributeValidators = connectorValidators{
+	strings: map[string][]validator.String{
+		"s3.remote_url":      {remoteURL("s3")},
+		"gcs.remote_url":     {remoteURL("gs")},
+		"azure.remote_url":   {remoteURL("az")},
+		"dropbox.remote_url": {remoteURL("dropbox")},
+		"box.remote_url":     {remoteURL("box")},
+
+		"jira.url":       {httpURL()},
+		"confluence.url": {httpURL()},
+
+		// Each connector authenticates with only one of these.
+		"confluence.password":  {conflictsWithSibling("api_token", "token")},
+		"confluence.api_token": {conflictsWithSibling("token")},
+		"jira.password":        {conflictsWithSibling("token")},
+		"azure.account_key":    {conflictsWithSibling("connection_string", "sas_token")},
+		"azure.sas_token":      {conflictsWithSibling("connection_string")},
+	},
+	int64s: map[string][]validator.Int64{
+		"*.port":       {int64validator.Between(1, 65535)},
+		"*.batch_size": {int64validator.AtLeast(1)},
+	},
+	lists: map[string][]validator.List{
+		"*.*":                 {listvalidator.SizeAtLeast(1)},
+		"elasticsearch.hosts": {listvalidator.ValueStringsAre(httpURL())},
+	},
+}
+
+// withConnectorValidators returns s with the validators of v added to the
+// attributes of its connector blocks.
+func withConnectorValidators(s schema.Schema, v connectorValidators) schema.Schema {
+	for block, a := range s.Attributes {
+		nested, ok := a.(schema.SingleNestedAttribute)
+		if !ok {
+			continue
+		}
+
+		attrs := make(map[string]schema.Attribute, len(nested.Attributes))
+		for name, na := range nested.Attributes {
+			switch na := na.(type) {
+			case schema.StringAttribute:
+				na.Validators = append(na.Validators, lookupValidators(v.strings, block, name)...)
+				attrs[name] = na
+			case schema.Int64Attribute:
+				na.Validators = append(na.Validators, lookupValidators(v.int64s, block, name)...)
+				attrs[name] = na
+			case schema.ListAttribute:
+				na.Validators = append(na.Validators, lookupValidators(v.lists, block, name)...)
+				attrs[name] = na
+			default:
+				attrs[name] = na
+			}
+		}
+
+		nested.Attributes = attrs
+		s.Attributes[block] = nested
+	}
+
+	return s
+}
+
+func lookupValidators[T any](m map[string][]T, block, name string) []T {
+	var validators []T
+	for _, key := range []string{"*.*", "*." + name, block + ".*", block + "." + name} {
+		validators = append(validators, m[key]...)
+	}
+
+	return validators
+}
+
+// conflictsWithSibling returns a validator that rejects the attribute when any
+// of the named attributes of the same block is also configured.
+func conflictsWithSibling(names ...string) validator.String {
+	expressions := make([]path.Expression, 0, len(names))
+	for _, name := range names {
+		expressions = append(expressions, path.MatchRelative().AtParent().AtName(name))
+	}
+
+	return stringvalidator.ConflictsWith(expressions...)
+}
+
+// urlValidator checks that a string is a URL with one of the given schemes.
+type urlValidator struct {
+	schemes     []string
+	requireHost bool
+}
+
+var _ validator.String = urlValidator{}
+
+// remoteURL returns a validator for connector remote URLs such as
+// s3://bucket/path.
+func remoteURL(scheme string) urlValidator {
+	return urlValidator{schemes: []string{scheme}}
+}
+
+// httpURL returns a validator for absolute http or https URLs.
+func httpURL() urlValidator {
+	return urlValidator{schemes: []string{"https", "http"}, requireHost: true}
+}
+
+func (v urlValidator) Description(ctx context.Context) string {
+	schemes := make([]string, 0, len(v.schemes))
+	for _, scheme := range v.schemes {
+		schemes = append(schemes, scheme+"://")
+	}
+
+	return "value must be a URL starting with " + strings.Join(schemes, " or ")
+}
+
+func (v urlValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v urlValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	u, err := url.Parse(value)
+	if err != nil || !hasPrefixFold(value, u.Scheme+"://") || !containsFold(v.schemes, u.Scheme) || (v.requireHost && u.Host == "") {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid URL",
+			fmt.Sprintf("Attribute %s %s, got: %q", req.Path, v.Description(ctx), value),
+		)
+	}
+}
+
+// hasPrefixFold reports whether s begins with prefix, ignoring case, as URL
+// schemes are case-insensitive.
+func hasPrefixFold(s, prefix string) bool {
+	return len(s) >= len(prefix) && strings.EqualFold(s[:len(prefix)], prefix)
+}
+
+func containsFold(values []string, s string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,372 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testValidateConfig runs the config validators of r against a config with
+// the given top-level attributes set and every other attribute null.
+func testValidateConfig(t *testing.T, r resource.ResourceWithConfigValidators, set map[string]func(tftypes.Type) tftypes.Value) string {
+	t.Helper()
+
+	s, raw := testObject(t, r, set)
+	req := resource.ValidateConfigRequest{
+		Config: tfsdk.Config{Schema: s, Raw: raw},
+	}
+
+	var diags []string
+	for _, v := range r.ConfigValidators(t.Context()) {
+		var resp resource.ValidateConfigResponse
+		v.ValidateResource(t.Context(), req, &resp)
+
+		for _, d := range resp.Diagnostics.Errors() {
+			diags = append(diags, d.Summary()+": "+d.Detail())
+		}
+	}
+
+	return strings.Join(diags, "\n")
+}
+
+// testObject returns the schema of r and a value of it with the given
+// top-level attributes set and every other attribute null. A nil set returns
+// a null value.
+func testObject(t *testing.T, r resource.Resource, set map[string]func(tftypes.Type) tftypes.Value) (schema.Schema, tftypes.Value) {
+	t.Helper()
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(t.Context(), resource.SchemaRequest{}, &schemaResp)
+
+	typ, ok := schemaResp.Schema.Type().TerraformType(t.Context()).(tftypes.Object)
+	if !ok {
+		t.Fatal("resource schema is not an object")
+	}
+
+	if set == nil {
+		return schemaResp.Schema, tftypes.NewValue(typ, nil)
+	}
+
+	attrs := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+	for name, attrType := range typ.AttributeTypes {
+		attrs[name] = tftypes.NewValue(attrType, nil)
+		if value, ok := set[name]; ok {
+			attrs[name] = value(attrType)
+		}
+	}
+
+	return schemaResp.Schema, tftypes.NewValue(typ, attrs)
+}
+
+// testUnknownBlock returns an unknown value of typ.
+func testUnknownBlock(typ tftypes.Type) tftypes.Value {
+	return tftypes.NewValue(typ, tftypes.UnknownValue)
+}
+
+// testEmptyBlock returns typ with every attribute null.
+func testEmptyBlock(typ tftypes.Type) tftypes.Value {
+	obj, _ := typ.(tftypes.Object)
+
+	attrs := make(map[string]tftypes.Value, len(obj.AttributeTypes))
+	for name, attrType := range obj.AttributeTypes {
+		attrs[name] = tftypes.NewValue(attrType, nil)
+	}
+
+	return tftypes.NewValue(typ, attrs)
+}
+
+func TestConnectorExactlyOneOf(t *testing.T) {
+	type blocks = map[string]func(tftypes.Type) tftypes.Value
+
+	tests := []struct {
+		name     string
+		resource resource.ResourceWithConfigValidators
+		set      blocks
+		wantErr  string
+	}{
+		{name: "source with one block", resource: &sourceResource{}, set: blocks{"s3": testEmptyBlock}},
+		{name: "source with an unknown block", resource: &sourceResource{}, set: blocks{"gcs": testUnknownBlock}},
+		{name: "source without blocks", resource: &sourceResource{}, wantErr: "Missing Attribute Configuration"},
+		{name: "source with two blocks", resource: &sourceResource{}, set: blocks{"s3": testEmptyBlock, "azure": testEmptyBlock}, wantErr: "Invalid Attribute Combination"},
+		{name: "destination with one block", resource: &destinationResource{}, set: blocks{"pinecone": testEmptyBlock}},
+		{name: "destination without blocks", resource: &destinationResource{}, wantErr: "Missing Attribute Configuration"},
+		{name: "destination with two blocks", resource: &destinationResource{}, set: blocks{"s3": testEmptyBlock, "weaviate_cloud": testEmptyBlock}, wantErr: "Invalid Attribute Combination"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := testValidateConfig(t, tt.resource, tt.set)
+
+			if tt.wantErr == "" && got != "" {
+				t.Fatalf("ConfigValidators() errors = %s, want none", got)
+			}
+
+			if !strings.Contains(got, tt.wantErr) {
+				t.Fatalf("ConfigValidators() errors = %q, want %q", got, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestConnectorExactlyOneOfCoversSchema checks that the validator lists every
+// connector block of the schema, so that new connectors are included.
+func TestConnectorExactlyOneOfCoversSchema(t *testing.T) {
+	for _, r := range []resource.ResourceWithConfigValidators{&sourceResource{}, &destinationResource{}} {
+		t.Run(fmt.Sprintf("%T", r), func(t *testing.T) {
+			var schemaResp resource.SchemaResponse
+			r.Schema(t.Context(), resource.SchemaRequest{}, &schemaResp)
+
+			typ, _ := schemaResp.Schema.Type().TerraformType(t.Context()).(tftypes.Object)
+
+			for name, attrType := range typ.AttributeTypes {
+				if _, ok := attrType.(tftypes.Object); !ok {
+					continue
+				}
+
+				got := testValidateConfig(t, r, map[string]func(tftypes.Type) tftypes.Value{name: testEmptyBlock})
+				if got != "" {
+					t.Errorf("%s alone: ConfigValidators() errors = %s, want none", name, got)
+				}
+			}
+		})
+	}
+}
+
+// testRemoteURLBlock returns a block value with only remote_url set.
+func testRemoteURLBlock(remoteURL string) func(tftypes.Type) tftypes.Value {
+	return func(typ tftypes.Type) tftypes.Value {
+		obj, _ := typ.(tftypes.Object)
+
+		attrs := make(map[string]tftypes.Value, len(obj.AttributeTypes))
+		for name, attrType := range obj.AttributeTypes {
+			attrs[name] = tftypes.NewValue(attrType, nil)
+		}
+		attrs["remote_url"] = tftypes.NewValue(tftypes.String, remoteURL)
+
+		return tftypes.NewValue(typ, attrs)
+	}
+}
+
+func TestConnectorChangeRequiresReplace(t *testing.T) {
+	type blocks = map[string]func(tftypes.Type) tftypes.Value
+
+	tests := []struct {
+		name     string
+		resource resource.ResourceWithModifyPlan
+		prior    blocks
+		planned  blocks
+		want     []string
+	}{
+		{name: "source create", resource: &sourceResource{}, planned: blocks{"s3": testRemoteURLBlock("s3://bucket/")}},
+		{name: "source destroy", resource: &sourceResource{}, prior: blocks{"s3": testRemoteURLBlock("s3://bucket/")}},
+		{name: "source update in place", resource: &sourceResource{}, prior: blocks{"s3": testRemoteURLBlock("s3://old/")}, planned: blocks{"s3": testRemoteURLBlock("s3://new/")}},
+		{name: "source connector change", resource: &sourceResource{}, prior: blocks{"s3": testRemoteURLBlock("s3://bucket/")}, planned: blocks{"gcs": testRemoteURLBlock("gs://bucket/")}, want: []string{"s3", "gcs"}},
+		{name: "source unknown connector", resource: &sourceResource{}, prior: blocks{"s3": testEmptyBlock}, planned: blocks{"azure": testUnknownBlock}, want: []string{"s3", "azure"}},
+		{name: "source to custom connector", resource: &sourceResource{}, prior: blocks{"s3": testEmptyBlock}, planned: blocks{"custom": testCustomBlock("slack", "{}")}, want: []string{"s3", "custom"}},
+		{name: "source custom config change", resource: &sourceResource{}, prior: blocks{"custom": testCustomBlock("slack", "{}")}, planned: blocks{"custom": testCustomBlock("slack", `{"token": "xoxb"}`)}},
+		{name: "source custom type change", resource: &sourceResource{}, prior: blocks{"custom": testCustomBlock("slack", "{}")}, planned: blocks{"custom": testCustomBlock("notion", "{}")}, want: []string{"custom.type"}},
+		{name: "destination update in place", resource: &destinationResource{}, prior: blocks{"pinecone": testEmptyBlock}, planned: blocks{"pinecone": testEmptyBlock}},
+		{name: "destination connector change", resource: &destinationResource{}, prior: blocks{"s3": testRemoteURLBlock("s3://bucket/")}, planned: blocks{"weaviate_cloud": testEmptyBlock}, want: []string{"s3", "weaviate_cloud"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, prior := testObject(t, tt.resource, tt.prior)
+			_, planned := testObject(t, tt.resource, tt.planned)
+
+			req := resource.ModifyPlanRequest{
+				State: tfsdk.State{Schema: s, Raw: prior},
+				Plan:  tfsdk.Plan{Schema: s, Raw: planned},
+			}
+			resp := resource.ModifyPlanResponse{Plan: req.Plan}
+			tt.resource.ModifyPlan(t.Context(), req, &resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("ModifyPlan() diagnostics = %v", resp.Diagnostics)
+			}
+
+			var got []string
+			for _, p := range resp.RequiresReplace {
+				got = append(got, p.String())
+			}
+
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Fatalf("ModifyPlan() RequiresReplace = %v, want %v", got, tt.want)
+			}
+
+			if len(tt.want) > 0 && resp.Diagnostics.WarningsCount() != 1 {
+				t.Errorf("ModifyPlan() diagnostics = %v, want a replacement warning", resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestPlannedConnectorType(t *testing.T) {
+	type blocks = map[string]func(tftypes.Type) tftypes.Value
+
+	tests := []struct {
+		name     string
+		resource resource.ResourceWithModifyPlan
+		planned  blocks
+		want     types.String
+	}{
+		{name: "source", resource: &sourceResource{}, planned: blocks{"s3": testRemoteURLBlock("s3://bucket/")}, want: types.StringValue("s3")},
+		{name: "source named differently", resource: &sourceResource{}, planned: blocks{"kafka_cloud": testEmptyBlock}, want: types.StringValue("kafka-cloud")},
+		{name: "source unknown connector", resource: &sourceResource{}, planned: blocks{"azure": testUnknownBlock}, want: types.StringValue("azure")},
+		{name: "source custom connector", resource: &sourceResource{}, planned: blocks{"custom": testCustomBlock("slack", "{}")}, want: types.StringValue("slack")},
+		{name: "source unknown custom connector", resource: &sourceResource{}, planned: blocks{"custom": testUnknownBlock}, want: types.StringUnknown()},
+		{name: "source no connector", resource: &sourceResource{}, planned: blocks{}, want: types.StringUnknown()},
+		{name: "destination named differently", resource: &destinationResource{}, planned: blocks{"kafka_cloud": testEmptyBlock}, want: types.StringValue("kafka-cloud")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, prior := testObject(t, tt.resource, nil)
+			_, planned := testObject(t, tt.resource, tt.planned)
+
+			req := resource.ModifyPlanRequest{
+				State: tfsdk.State{Schema: s, Raw: prior},
+				Plan:  tfsdk.Plan{Schema: s, Raw: planned},
+			}
+			resp := resource.ModifyPlanResponse{Plan: req.Plan}
+			tt.resource.ModifyPlan(t.Context(), req, &resp)
+
+			var got types.String
+			resp.Diagnostics.Append(resp.Plan.GetAttribute(t.Context(), path.Root("type"), &got)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("ModifyPlan() diagnostics = %v", resp.Diagnostics)
+			}
+
+			if !got.Equal(tt.want) {
+				t.Errorf("ModifyPlan() type = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+// testValidateResourceConfig validates a config of the given resource type
+// through the provider server, with block set to attrs and the name set, and
+// returns the error diagnostics. Only the given attributes are set, so
+// missing required attributes are not reported.
+func testValidateResourceConfig(t *testing.T, typeName, block string, attrs map[string]any) string {
+	t.Helper()
+
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	schemaResp, err := server.GetProviderSchema(t.Context(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	typ, ok := schemaResp.ResourceSchemas[typeName].ValueType().(tftypes.Object)
+	if !ok {
+		t.Fatal("resource schema is not an object")
+	}
+
+	values := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+	for name, attrType := range typ.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+	}
+	values["name"] = tftypes.NewValue(tftypes.String, "connector")
+
+	blockType, _ := typ.AttributeTypes[block].(tftypes.Object)
+	blockValues := make(map[string]tftypes.Value, len(blockType.AttributeTypes))
+	for name, attrType := range blockType.AttributeTypes {
+		switch v := attrs[name].(type) {
+		case nil:
+			blockValues[name] = tftypes.NewValue(attrType, nil)
+		case int:
+			blockValues[name] = tftypes.NewValue(attrType, big.NewFloat(float64(v)))
+		case []string:
+			elems := make([]tftypes.Value, 0, len(v))
+			for _, e := range v {
+				elems = append(elems, tftypes.NewValue(tftypes.String, e))
+			}
+			blockValues[name] = tftypes.NewValue(attrType, elems)
+		default:
+			blockValues[name] = tftypes.NewValue(attrType, v)
+		}
+	}
+	values[block] = tftypes.NewValue(blockType, blockValues)
+
+	config, err := tfprotov6.NewDynamicValue(typ, tftypes.NewValue(typ, values))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := server.ValidateResourceConfig(t.Context(), &tfprotov6.ValidateResourceConfigRequest{TypeName: typeName, Config: &config})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var errs []string
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov6.DiagnosticSeverityError && d.Summary != "Missing Configuration for Required Attribute" {
+			errs = append(errs, d.Summary+": "+d.Detail)
+		}
+	}
+
+	return strings.Join(errs, "\n")
+}
+
+func TestConnectorAttributeValidators(t *testing.T) {
+	tests := []struct {
+		name     string
+		typeName string
+		block    string
+		attrs    map[string]any
+		wantErr  string
+	}{
+		{name: "s3 remote URL", typeName: "unstructured_source", block: "s3", attrs: map[string]any{"remote_url": "s3://bucket/prefix/"}},
+		{name: "s3 remote URL with an upper case scheme", typeName: "unstructured_source", block: "s3", attrs: map[string]any{"remote_url": "S3://bucket/prefix/"}},
+		{name: "s3 remote URL with the wrong scheme", typeName: "unstructured_source", block: "s3", attrs: map[string]any{"remote_url": "https://bucket/"}, wantErr: "URL starting with s3://"},
+		{name: "gcs remote URL", typeName: "unstructured_destination", block: "gcs", attrs: map[string]any{"remote_url": "gs://bucket/"}},
+		{name: "gcs remote URL with the wrong scheme", typeName: "unstructured_destination", block: "gcs", attrs: map[string]any{"remote_url": "s3://bucket/"}, wantErr: "URL starting with gs://"},
+		{name: "azure remote URL without a scheme", typeName: "unstructured_source", block: "azure", attrs: map[string]any{"remote_url": "container/path"}, wantErr: "URL starting with az://"},
+		{name: "dropbox remote URL", typeName: "unstructured_source", block: "dropbox", attrs: map[string]any{"remote_url": "dropbox://folder"}},
+		{name: "jira URL", typeName: "unstructured_source", block: "jira", attrs: map[string]any{"url": "https://example.atlassian.net"}},
+		{name: "jira URL with an upper case scheme", typeName: "unstructured_source", block: "jira", attrs: map[string]any{"url": "HTTPS://example.atlassian.net"}},
+		{name: "jira URL without a host", typeName: "unstructured_source", block: "jira", attrs: map[string]any{"url": "https://"}, wantErr: "Invalid URL"},
+		{name: "confluence URL that is not a URL", typeName: "unstructured_source", block: "confluence", attrs: map[string]any{"url": "example.atlassian.net"}, wantErr: "Invalid URL"},
+		{name: "elasticsearch hosts", typeName: "unstructured_destination", block: "elasticsearch", attrs: map[string]any{"hosts": []string{"https://es.example.com:9200"}}},
+		{name: "elasticsearch host that is not a URL", typeName: "unstructured_source", block: "elasticsearch", attrs: map[string]any{"hosts": []string{"es.example.com:9200"}}, wantErr: "Invalid URL"},
+		{name: "empty list", typeName: "unstructured_source", block: "elasticsearch", attrs: map[string]any{"hosts": []string{}}, wantErr: "at least 1"},
+		{name: "port", typeName: "unstructured_source", block: "postgres", attrs: map[string]any{"port": 5432, "batch_size": 100}},
+		{name: "negative port", typeName: "unstructured_source", block: "postgres", attrs: map[string]any{"port": -1}, wantErr: "between 1 and 65535"},
+		{name: "port out of range", typeName: "unstructured_destination", block: "redis", attrs: map[string]any{"port": 70000}, wantErr: "between 1 and 65535"},
+		{name: "zero batch size", typeName: "unstructured_destination", block: "postgres", attrs: map[string]any{"batch_size": 0}, wantErr: "at least 1"},
+		{name: "confluence with one credential", typeName: "unstructured_source", block: "confluence", attrs: map[string]any{"api_token": "t"}},
+		{name: "confluence with two credentials", typeName: "unstructured_source", block: "confluence", attrs: map[string]any{"password": "p", "token": "t"}, wantErr: "Invalid Attribute Combination"},
+		{name: "azure with two credentials", typeName: "unstructured_source", block: "azure", attrs: map[string]any{"account_key": "k", "sas_token": "t"}, wantErr: "Invalid Attribute Combination"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := testValidateResourceConfig(t, tt.typeName, tt.block, tt.attrs)
+
+			if tt.wantErr == "" && got != "" {
+				t.Fatalf("ValidateResourceConfig() errors = %s, want none", got)
+			}
+
+			if !strings.Contains(got, tt.wantErr) {
+				t.Fatalf("ValidateResourceConfig() errors = %q, want %q", got, tt.wantErr)
+			}
+		})
+	}
+}
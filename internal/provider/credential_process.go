@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// credentialProcessOutput is the JSON document a credential process must
+// write to stdout.
+type credentialProcessOutput struct {
+	APIKey   string `json:"api_key"`
+	Endpoint string `json:"endpoint,omitempty"`
+}
+
+// credentialProcessCache holds the output of each credential process that
+// has run successfully, keyed by command line, so that the command runs at
+// most once for the lifetime of the provider process.
+var credentialProcessCache = struct {
+	sync.Mutex
+	results map[string]credentials
+}{
+	results: map[string]credentials{},
+}
+
+// runCredentialProcess runs command through the system shell and parses the
+// API key and optional endpoint from its output. Successful results are
+// cached; failures are not, so a later Configure call tries again.
+func runCredentialProcess(ctx context.Context, command string) (credentials, error) {
+	credentialProcessCache.Lock()
+	defer credentialProcessCache.Unlock()
+
+	if creds, ok := credentialProcessCache.results[command]; ok {
+		return creds, nil
+	}
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd.exe", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "/bin/sh", "-c", command)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return credentials{}, fmt.Errorf("credential process failed: %w: %s", err, msg)
+		}
+
+		return credentials{}, fmt.Errorf("credential process failed: %w", err)
+	}
+
+	var out credentialProcessOutput
+	if err := json.Unmarshal(stdout.Bytes(), &out); err != nil {
+		return credentials{}, fmt.Errorf("credential process returned invalid JSON: %w", err)
+	}
+
+	if out.APIKey == "" {
+		return credentials{}, errors.New("credential process output is missing api_key")
+	}
+
+	creds := credentials{
+		APIKey:   out.APIKey,
+		Endpoint: out.Endpoint,
+	}
+
+	credentialProcessCache.results[command] = creds
+
+	return creds, nil
+}
@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRunCredentialProcess(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test commands require a POSIX shell")
+	}
+
+	tests := []struct {
+		name    string
+		command string
+		want    credentials
+		wantErr string
+	}{
+		{
+			name:    "key only",
+			command: `echo '{"api_key": "process-key"}'`,
+			want:    credentials{APIKey: "process-key"},
+		},
+		{
+			name:    "key and endpoint",
+			command: `echo '{"api_key": "process-key", "endpoint": "https://process.example.com/api/v1"}'`,
+			want:    credentials{APIKey: "process-key", Endpoint: "https://process.example.com/api/v1"},
+		},
+		{
+			name:    "command fails",
+			command: `echo "vault is sealed" >&2; exit 3`,
+			wantErr: "vault is sealed",
+		},
+		{
+			name:    "invalid JSON",
+			command: `echo not-json`,
+			wantErr: "invalid JSON",
+		},
+		{
+			name:    "missing key",
+			command: `echo '{"endpoint": "https://process.example.com/api/v1"}'`,
+			wantErr: "missing api_key",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := runCredentialProcess(t.Context(), tt.command)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("runCredentialProcess() error = %v, want error containing %q", err, tt.wantErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("runCredentialProcess() error = %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("runCredentialProcess() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunCredentialProcessCached(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test commands require a POSIX shell")
+	}
+
+	counter := filepath.Join(t.TempDir(), "runs")
+	command := `echo run >> '` + counter + `'; echo '{"api_key": "cached-key"}'`
+
+	for range 3 {
+		if _, err := runCredentialProcess(t.Context(), command); err != nil {
+			t.Fatalf("runCredentialProcess() error = %v", err)
+		}
+	}
+
+	b, err := os.ReadFile(counter)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if runs := strings.Count(string(b), "run"); runs != 1 {
+		t.Errorf("expected the command to run once, ran %d times", runs)
+	}
+}
+
+func TestResolveCredentialsProcess(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test commands require a POSIX shell")
+	}
+
+	t.Setenv(envAPIKey, "env-key")
+	t.Setenv(envEndpoint, "https://env.example.com/api/v1")
+	t.Setenv(envCredentialsFile, filepath.Join(t.TempDir(), "missing"))
+
+	got, err := resolveCredentials(t.Context(), ProviderModel{
+		CredentialProcess: types.StringValue(`echo '{"api_key": "process-key"}'`),
+	})
+	if err != nil {
+		t.Fatalf("resolveCredentials() error = %v", err)
+	}
+
+	want := credentials{APIKey: "process-key", Endpoint: "https://env.example.com/api/v1"}
+	if got != want {
+		t.Errorf("resolveCredentials() = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// defaultProfile is the profile used when none is selected explicitly.
+	defaultProfile = "default"
+
+	envAPIKey          = "UNSTRUCTURED_API_KEY"
+	envEndpoint        = "UNSTRUCTURED_API_URL"
+	envProfile         = "UNSTRUCTURED_PROFILE"
+	envCredentialsFile = "UNSTRUCTURED_CREDENTIALS_FILE"
+)
+
+// credentials are the resolved settings used to build the API client.
+type credentials struct {
+	APIKey   string
+	Endpoint string
+}
+
+// profile is a named section of the shared credentials file.
+type profile map[string]string
+
+// defaultCredentialsFile returns the location of the shared credentials file,
+// which is $XDG_CONFIG_HOME/unstructured/credentials, falling back to
+// ~/.config/unstructured/credentials. It returns an empty string if neither
+// directory can be determined.
+func defaultCredentialsFile() string {
+	if dir := os.Getenv("XDG_CONFIG_HOME"); dir != "" {
+		return filepath.Join(dir, "unstructured", "credentials")
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Join(home, ".config", "unstructured", "credentials")
+}
+
+// readCredentialsFile parses a shared credentials file. The file is made of
+// named sections holding key/value pairs, for example:
+//
+//	[default]
+//	api_key  = ...
+//
+//	[prod]
+//	api_key  = ...
+//	endpoint = https://platform.unstructuredapp.io/api/v1
+//
+// Blank lines and lines starting with '#' or ';' are ignored.
+func readCredentialsFile(path string) (map[string]profile, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = f.Close() }()
+
+	profiles := map[string]profile{}
+
+	var current profile
+
+	scanner := bufio.NewScanner(f)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+
+		switch {
+		case line == "", strings.HasPrefix(line, "#"), strings.HasPrefix(line, ";"):
+			continue
+
+		case strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]"):
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			if name == "" {
+				return nil, fmt.Errorf("%s:%d: empty profile name", path, n)
+			}
+
+			if _, ok := profiles[name]; !ok {
+				profiles[name] = profile{}
+			}
+
+			current = profiles[name]
+
+		default:
+			key, value, ok := strings.Cut(line, "=")
+			if !ok {
+				return nil, fmt.Errorf("%s:%d: expected key = value", path, n)
+			}
+
+			if current == nil {
+				return nil, fmt.Errorf("%s:%d: %q is not inside a [profile] section", path, n, strings.TrimSpace(key))
+			}
+
+			current[strings.TrimSpace(key)] = strings.TrimSpace(value)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	return profiles, nil
+}
+
+// loadProfile returns the named profile from the credentials file at path.
+// If the profile was not selected explicitly, a missing file or a missing
+// default profile is not an error and an empty profile is returned.
+func loadProfile(path, name string, explicit bool) (profile, error) {
+	profiles, err := readCredentialsFile(path)
+	if errors.Is(err, fs.ErrNotExist) && !explicit {
+		return profile{}, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to load credentials file: %w", err)
+	}
+
+	p, ok := profiles[name]
+	if !ok {
+		if !explicit {
+			return profile{}, nil
+		}
+
+		return nil, fmt.Errorf("profile %q not found in %s", name, path)
+	}
+
+	return p, nil
+}
+
+// resolveCredentials determines the API key and endpoint. Each setting is
+// taken from the first of these that provides it:
+//
+//  1. the api_key and endpoint provider attributes,
+//  2. the output of the credential_process command,
+//  3. the UNSTRUCTURED_API_KEY and UNSTRUCTURED_API_URL environment variables,
+//  4. the selected profile in the shared credentials file.
+//
+// The profile is selected by the profile attribute, then the
+// UNSTRUCTURED_PROFILE environment variable, and defaults to "default".
+func resolveCredentials(ctx context.Context, data ProviderModel) (credentials, error) {
+	creds := credentials{
+		APIKey:   data.APIKey.ValueString(),
+		Endpoint: data.Endpoint.ValueString(),
+	}
+
+	if command := data.CredentialProcess.ValueString(); command != "" && creds.APIKey == "" {
+		out, err := runCredentialProcess(ctx, command)
+		if err != nil {
+			return creds, err
+		}
+
+		creds.APIKey = out.APIKey
+
+		if creds.Endpoint == "" {
+			creds.Endpoint = out.Endpoint
+		}
+	}
+
+	if creds.APIKey == "" {
+		creds.APIKey = os.Getenv(envAPIKey)
+	}
+
+	if creds.Endpoint == "" {
+		creds.Endpoint = os.Getenv(envEndpoint)
+	}
+
+	name := data.Profile.ValueString()
+	if name == "" {
+		name = os.Getenv(envProfile)
+	}
+
+	explicit := name != ""
+	if !explicit {
+		name = defaultProfile
+	}
+
+	// A complete explicit or environment configuration doesn't need the file,
+	// unless a profile was asked for by name.
+	if creds.APIKey != "" && creds.Endpoint != "" && !explicit {
+		return creds, nil
+	}
+
+	path := data.CredentialsFile.ValueString()
+	if path == "" {
+		path = os.Getenv(envCredentialsFile)
+	}
+
+	if path == "" {
+		path = defaultCredentialsFile()
+	}
+
+	p, err := loadProfile(path, name, explicit)
+	if err != nil {
+		return creds, err
+	}
+
+	if creds.APIKey == "" {
+		creds.APIKey = p["api_key"]
+	}
+
+	if creds.Endpoint == "" {
+		creds.Endpoint = p["endpoint"]
+	}
+
+	return creds, nil
+}
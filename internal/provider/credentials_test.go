@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const testCredentialsFile = `
+# shared credentials
+[default]
+api_key  = default-key
+endpoint = https://default.example.com/api/v1
+
+[staging]
+api_key = staging-key
+
+; prod uses its own deployment
+[prod]
+api_key  = prod-key
+endpoint = https://prod.example.com/api/v1
+`
+
+func TestResolveCredentials(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "credentials")
+	if err := os.WriteFile(file, []byte(testCredentialsFile), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		data    ProviderModel
+		env     map[string]string
+		want    credentials
+		wantErr bool
+	}{
+		{
+			name: "default profile",
+			want: credentials{APIKey: "default-key", Endpoint: "https://default.example.com/api/v1"},
+		},
+		{
+			name: "profile attribute",
+			data: ProviderModel{Profile: types.StringValue("prod")},
+			want: credentials{APIKey: "prod-key", Endpoint: "https://prod.example.com/api/v1"},
+		},
+		{
+			name: "profile environment variable",
+			env:  map[string]string{envProfile: "prod"},
+			want: credentials{APIKey: "prod-key", Endpoint: "https://prod.example.com/api/v1"},
+		},
+		{
+			name: "profile attribute wins over environment",
+			data: ProviderModel{Profile: types.StringValue("staging")},
+			env:  map[string]string{envProfile: "prod"},
+			want: credentials{APIKey: "staging-key"},
+		},
+		{
+			name: "environment wins over profile",
+			data: ProviderModel{Profile: types.StringValue("prod")},
+			env:  map[string]string{envAPIKey: "env-key"},
+			want: credentials{APIKey: "env-key", Endpoint: "https://prod.example.com/api/v1"},
+		},
+		{
+			name: "configuration wins over environment",
+			data: ProviderModel{
+				APIKey:   types.StringValue("config-key"),
+				Endpoint: types.StringValue("https://config.example.com/api/v1"),
+			},
+			env:  map[string]string{envAPIKey: "env-key", envEndpoint: "https://env.example.com/api/v1"},
+			want: credentials{APIKey: "config-key", Endpoint: "https://config.example.com/api/v1"},
+		},
+		{
+			name:    "unknown profile",
+			data:    ProviderModel{Profile: types.StringValue("missing")},
+			wantErr: true,
+		},
+		{
+			name:    "missing file with explicit profile",
+			data:    ProviderModel{Profile: types.StringValue("prod")},
+			env:     map[string]string{envCredentialsFile: filepath.Join(t.TempDir(), "missing")},
+			wantErr: true,
+		},
+		{
+			name: "missing file without profile",
+			env:  map[string]string{envCredentialsFile: filepath.Join(t.TempDir(), "missing")},
+			want: credentials{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(envAPIKey, "")
+			t.Setenv(envEndpoint, "")
+			t.Setenv(envProfile, "")
+			t.Setenv(envCredentialsFile, file)
+
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+
+			got, err := resolveCredentials(t.Context(), tt.data)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("resolveCredentials() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("resolveCredentials() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadCredentialsFileInvalid(t *testing.T) {
+	tests := map[string]string{
+		"key outside section": "api_key = key\n",
+		"missing separator":   "[default]\napi_key\n",
+		"empty profile name":  "[]\napi_key = key\n",
+	}
+
+	for name, content := range tests {
+		t.Run(name, func(t *testing.T) {
+			file := filepath.Join(t.TempDir(), "credentials")
+			if err := os.WriteFile(file, []byte(content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			if _, err := readCredentialsFile(file); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/convert"
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/resource_destination"
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/resource_source"
+	"github.com/aws-gopher/unstructured-sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// customConnectorValidator checks the custom connector block of a source or
+// destination. Its type must be one the SDK can read back, or the resource
+// could not be read after it is created, and must not have a block of its
+// own, as it would read back into that block instead.
+type customConnectorValidator struct {
+	kind string
+
+	// block returns the connector block for connectors of type typ, or "" if
+	// there is none, and an error if the SDK cannot read them.
+	block func(typ string) (string, error)
+}
+
+var _ resource.ConfigValidator = customConnectorValidator{}
+
+func (v customConnectorValidator) Description(ctx context.Context) string {
+	return "the custom connector block must have a connector type without a block of its own and a JSON object config"
+}
+
+func (v customConnectorValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v customConnectorValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var custom convert.CustomConnectorValue
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("custom"), &custom)...)
+	if resp.Diagnostics.HasError() || custom.IsNull() || custom.IsUnknown() {
+		return
+	}
+
+	if typ := custom.ConnectorType(); !typ.IsNull() && !typ.IsUnknown() {
+		block, err := v.block(typ.ValueString())
+
+		switch {
+		case err != nil:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("custom").AtName("type"),
+				"Unsupported connector type",
+				fmt.Sprintf("The provider cannot read %s connectors of type %q back from the API, so they cannot be managed: %s", v.kind, typ.ValueString(), err),
+			)
+		case block != "":
+			resp.Diagnostics.AddAttributeError(
+				path.Root("custom").AtName("type"),
+				"Connector type has its own block",
+				fmt.Sprintf("Use the %s block for %s connectors of type %q. They read back into that block, so the custom block would never match.", block, v.kind, typ.ValueString()),
+			)
+		}
+	}
+
+	if config := custom.ConfigJSON(); !config.IsNull() && !config.IsUnknown() {
+		var obj map[string]any
+		if err := json.Unmarshal([]byte(config.ValueString()), &obj); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("custom").AtName("config_json"),
+				"Invalid connector config",
+				fmt.Sprintf("The config of a custom %s connector must be a JSON object: %s", v.kind, err),
+			)
+		}
+	}
+}
+
+// sourceConnectorBlock returns the connector block of sources of type typ, by
+// having the SDK read an empty source of that type.
+func sourceConnectorBlock(typ string) (string, error) {
+	var source unstructured.Source
+	if err := json.Unmarshal(emptyConnector(typ), &source); err != nil {
+		return "", err
+	}
+
+	obj, _ := convert.Source(&source)
+
+	return connectorBlock(obj, resource_source.SourceConnectors), nil
+}
+
+// destinationConnectorBlock returns the connector block of destinations of
+// type typ, by having the SDK read an empty destination of that type.
+func destinationConnectorBlock(typ string) (string, error) {
+	var destination unstructured.Destination
+	if err := json.Unmarshal(emptyConnector(typ), &destination); err != nil {
+		return "", err
+	}
+
+	obj, _ := convert.Destination(&destination)
+
+	return connectorBlock(obj, resource_destination.DestinationConnectors), nil
+}
+
+func emptyConnector(typ string) []byte {
+	b, _ := json.Marshal(map[string]any{"type": typ, "config": map[string]any{}})
+	return b
+}
+
+func connectorBlock(obj convert.Object, blocks []string) string {
+	for _, block := range blocks {
+		if obj[block] != nil {
+			return block
+		}
+	}
+
+	return ""
+}
+
+// customConfigInput returns the API config input of a custom connector block,
+// which sends its config as is.
+func customConfigInput(custom convert.CustomConnectorValue) convert.RawConfigInput {
+	return convert.RawConfigInput{
+		ConnectorType: custom.ConnectorType().ValueString(),
+		Config:        json.RawMessage(custom.ConfigJSON().ValueString()),
+	}
+}
+
+// keepCustomConfig returns got, the custom connector block read from the API,
+// with the config JSON of prior when the API config still contains it. The API
+// may add defaults and the SDK adds the empty fields of its config types, which
+// would otherwise show as a difference from the configuration.
+func keepCustomConfig(prior, got convert.CustomConnectorValue) convert.CustomConnectorValue {
+	if prior.IsNull() || prior.IsUnknown() || got.IsNull() || got.IsUnknown() {
+		return got
+	}
+
+	priorConfig, gotConfig := prior.ConfigJSON(), got.ConfigJSON()
+	if priorConfig.IsUnknown() || !prior.ConnectorType().Equal(got.ConnectorType()) {
+		return got
+	}
+
+	var p, g any
+	if json.Unmarshal([]byte(priorConfig.ValueString()), &p) != nil || json.Unmarshal([]byte(gotConfig.ValueString()), &g) != nil {
+		return got
+	}
+
+	if !jsonContains(g, p) {
+		return got
+	}
+
+	return convert.NewCustomConnectorValue(got.ConnectorType().ValueString(), priorConfig.ValueString())
+}
+
+// unsupportedConnectorWarning warns when got, the custom connector block read
+// from the API, is set while prior is not. The API then returned a connector
+// type without a block of its own for an object the configuration manages
+// through a connector block, or that is being imported.
+func unsupportedConnectorWarning(kind, id string, prior, got convert.CustomConnectorValue) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if got.IsNull() || !prior.IsNull() {
+		return diags
+	}
+
+	diags.AddWarning(
+		fmt.Sprintf("Unsupported %s type", kind),
+		fmt.Sprintf("The %s %s has connector type %q, which none of the connector blocks of this provider version model. "+
+			"Its config was read into the custom block, which the configuration must use to manage it.", kind, id, got.ConnectorType().ValueString()),
+	)
+
+	return diags
+}
+
+// jsonContains reports whether the decoded JSON value a contains b: objects
+// contain the keys of b with values containing those of b, and any other
+// values are equal.
+func jsonContains(a, b any) bool {
+	bm, ok := b.(map[string]any)
+	if !ok {
+		return reflect.DeepEqual(a, b)
+	}
+
+	am, ok := a.(map[string]any)
+	if !ok {
+		return false
+	}
+
+	for k, bv := range bm {
+		if !jsonContains(am[k], bv) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/convert"
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/resource_source"
+	"github.com/aws-gopher/unstructured-sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testCustomBlock returns a custom connector block value.
+func testCustomBlock(typ, config string) func(tftypes.Type) tftypes.Value {
+	return func(t tftypes.Type) tftypes.Value {
+		return tftypes.NewValue(t, map[string]tftypes.Value{
+			"type":        tftypes.NewValue(tftypes.String, typ),
+			"config_json": tftypes.NewValue(tftypes.String, config),
+		})
+	}
+}
+
+func testCustomSourceModel(t *testing.T, id, typ, config string) *resource_source.SourceModel {
+	t.Helper()
+
+	model := testSourceModel(t, id, "custom", "")
+	model.Custom = convert.NewCustomConnectorValue(typ, config)
+
+	return model
+}
+
+func TestCustomConnectorCRUD(t *testing.T) {
+	// Formatted differently from what the SDK encodes
+	const config = `{ "token": "xoxb", "channels": ["general"] }`
+
+	c := newFakeClient()
+	r := NewSourceResource()
+	empty := testResource(t, r, c)
+
+	state, diags := testCRUD(t, r, empty, "Create", nil, testCustomSourceModel(t, "", unstructured.ConnectorTypeSlack, config))
+	if diags.HasError() {
+		t.Fatalf("Create() diagnostics = %v", diags)
+	}
+
+	if c.lastSourceConfig.Type() != unstructured.ConnectorTypeSlack {
+		t.Errorf("CreateSource() type = %s, want slack", c.lastSourceConfig.Type())
+	}
+
+	if b, err := json.Marshal(c.lastSourceConfig); err != nil || string(b) != `{"token":"xoxb","channels":["general"]}` {
+		t.Errorf("CreateSource() config = %s, %v, want the configured JSON", b, err)
+	}
+
+	var got resource_source.SourceModel
+	if diags := state.Get(t.Context(), &got); diags.HasError() {
+		t.Fatalf("State.Get() diagnostics = %v", diags)
+	}
+
+	if got.Custom.ConfigJSON().ValueString() != config {
+		t.Errorf("state custom.config_json = %s, want the configured JSON", got.Custom.ConfigJSON())
+	}
+
+	// Read keeps the configured JSON while the API config matches it
+	state, diags = testCRUD(t, r, empty, "Read", &got, nil)
+	if diags.HasError() {
+		t.Fatalf("Read() diagnostics = %v", diags)
+	}
+
+	var read resource_source.SourceModel
+	state.Get(t.Context(), &read)
+
+	if !read.Custom.Equal(got.Custom) {
+		t.Errorf("Read() custom = %s, want %s", read.Custom, got.Custom)
+	}
+
+	// and reads the API config when it differs, or when there is no prior
+	// config to keep, as after an import
+	c.sources[got.Id.ValueString()].Config = &unstructured.SlackSourceConnectorConfig{Channels: []string{"random"}, Token: "xoxb"}
+
+	for _, prior := range []*resource_source.SourceModel{&got, testSourceModel(t, got.Id.ValueString(), "custom", "")} {
+		state, diags = testCRUD(t, r, empty, "Read", prior, nil)
+		if diags.HasError() {
+			t.Fatalf("Read() diagnostics = %v", diags)
+		}
+
+		state.Get(t.Context(), &read)
+
+		if typ, config := read.Custom.ConnectorType().ValueString(), read.Custom.ConfigJSON().ValueString(); typ != "slack" || config != `{"channels":["random"],"token":"xoxb"}` {
+			t.Errorf("Read() custom = %s %s, want the API config", typ, config)
+		}
+	}
+}
+
+func TestCustomConnectorValidator(t *testing.T) {
+	type blocks = map[string]func(tftypes.Type) tftypes.Value
+
+	tests := []struct {
+		name     string
+		resource resource.ResourceWithConfigValidators
+		set      blocks
+		wantErr  string
+	}{
+		{name: "source", resource: &sourceResource{}, set: blocks{"custom": testCustomBlock("slack", `{"token": "xoxb"}`)}},
+		{name: "source with another block", resource: &sourceResource{}, set: blocks{"custom": testCustomBlock("slack", `{}`), "s3": testEmptyBlock}, wantErr: "Invalid Attribute Combination"},
+		{name: "source type with a block", resource: &sourceResource{}, set: blocks{"custom": testCustomBlock("kafka-cloud", `{}`)}, wantErr: "Use the kafka_cloud block"},
+		{name: "source type unknown to the SDK", resource: &sourceResource{}, set: blocks{"custom": testCustomBlock("notion", `{}`)}, wantErr: "Unsupported connector type"},
+		{name: "source config not an object", resource: &sourceResource{}, set: blocks{"custom": testCustomBlock("slack", `["general"]`)}, wantErr: "Invalid connector config"},
+		{name: "source unknown block", resource: &sourceResource{}, set: blocks{"custom": testUnknownBlock}},
+		{name: "destination type with a block", resource: &destinationResource{}, set: blocks{"custom": testCustomBlock("s3", `{}`)}, wantErr: "Use the s3 block"},
+		{name: "destination type unknown to the SDK", resource: &destinationResource{}, set: blocks{"custom": testCustomBlock("slack", `{}`)}, wantErr: "Unsupported connector type"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := testValidateConfig(t, tt.resource, tt.set)
+
+			if tt.wantErr == "" && got != "" {
+				t.Fatalf("ConfigValidators() errors = %s, want none", got)
+			}
+
+			if !strings.Contains(got, tt.wantErr) {
+				t.Fatalf("ConfigValidators() errors = %q, want %q", got, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestKeepCustomConfig(t *testing.T) {
+	const configured = `{"channels": ["general"], "token": "xoxb"}`
+
+	tests := []struct {
+		name           string
+		prior          convert.CustomConnectorValue
+		got            convert.CustomConnectorValue
+		wantConfigured bool
+	}{
+		{name: "same config", prior: convert.NewCustomConnectorValue("slack", configured), got: convert.NewCustomConnectorValue("slack", `{"token":"xoxb","channels":["general"]}`), wantConfigured: true},
+		{name: "API defaults", prior: convert.NewCustomConnectorValue("slack", configured), got: convert.NewCustomConnectorValue("slack", `{"channels":["general"],"token":"xoxb","start_date":null}`), wantConfigured: true},
+		{name: "changed value", prior: convert.NewCustomConnectorValue("slack", configured), got: convert.NewCustomConnectorValue("slack", `{"channels":["random"],"token":"xoxb"}`)},
+		{name: "dropped key", prior: convert.NewCustomConnectorValue("slack", configured), got: convert.NewCustomConnectorValue("slack", `{"channels":["general"]}`)},
+		{name: "changed type", prior: convert.NewCustomConnectorValue("slack", configured), got: convert.NewCustomConnectorValue("notion", `{"channels":["general"],"token":"xoxb"}`)},
+		{name: "no prior", got: convert.NewCustomConnectorValue("slack", `{"channels":["general"],"token":"xoxb"}`)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := keepCustomConfig(tt.prior, tt.got)
+
+			want := tt.got
+			if tt.wantConfigured {
+				want = convert.NewCustomConnectorValue(tt.got.ConnectorType().ValueString(), configured)
+			}
+
+			if !got.Equal(want) {
+				t.Errorf("keepCustomConfig() = %s, want %s", got, want)
+			}
+		})
+	}
+}
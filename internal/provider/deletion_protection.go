@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// planDeletionProtection plans the provider default of c for
+// deletion_protection when the configuration does not set it, so that
+// changing the default shows in the plan of every resource that follows it.
+func planDeletionProtection(ctx context.Context, c client, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.Config.Raw.IsNull() {
+		return
+	}
+
+	var configured types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("deletion_protection"), &configured)...)
+	if resp.Diagnostics.HasError() || !configured.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("deletion_protection"), types.BoolValue(clientDeletionProtection(c)))...)
+}
+
+// deletionProtection returns the deletion_protection of a resource in state,
+// or the provider default of c when the state has none, as after an import or
+// in state written by an earlier provider version.
+func deletionProtection(c client, v types.Bool) types.Bool {
+	if v.IsNull() || v.IsUnknown() {
+		return types.BoolValue(clientDeletionProtection(c))
+	}
+
+	return v
+}
+
+// checkDeletionProtection reports an error when the kind with the given ID
+// is protected from deletion.
+func checkDeletionProtection(kind, id string, v types.Bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !v.ValueBool() {
+		return diags
+	}
+
+	diags.AddAttributeError(
+		path.Root("deletion_protection"),
+		"Deletion protection enabled",
+		fmt.Sprintf("The %[1]s %[2]s has deletion_protection set, so it was not deleted. "+
+			"To delete it, set deletion_protection to false and apply that change before destroying or replacing the %[1]s.", kind, id),
+	)
+
+	return diags
+}
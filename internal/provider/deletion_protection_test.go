@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/resource_source"
+	"github.com/aws-gopher/unstructured-sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDeletionProtection(t *testing.T) {
+	tests := []struct {
+		name     string
+		resource func() resource.Resource
+		model    func(t *testing.T, protection types.Bool) any
+		exists   func(c *fakeClient) bool
+	}{
+		{
+			name:     "source",
+			resource: NewSourceResource,
+			model: func(t *testing.T, protection types.Bool) any {
+				model := testSourceModel(t, "source-1", "prod", "s3://prod/")
+				model.DeletionProtection = protection
+				return model
+			},
+			exists: func(c *fakeClient) bool { return c.sources["source-1"] != nil },
+		},
+		{
+			name:     "destination",
+			resource: NewDestinationResource,
+			model: func(t *testing.T, protection types.Bool) any {
+				model := testDestinationModel(t, "destination-1", "prod", "s3://prod/")
+				model.DeletionProtection = protection
+				return model
+			},
+			exists: func(c *fakeClient) bool { return c.destinations["destination-1"] != nil },
+		},
+		{
+			name:     "workflow",
+			resource: NewWorkflowResource,
+			model: func(t *testing.T, protection types.Bool) any {
+				model := testWorkflowModel(t, "workflow-1", "prod", "source-1")
+				model.DeletionProtection = protection
+				return model
+			},
+			exists: func(c *fakeClient) bool { return c.workflows["workflow-1"] != nil },
+		},
+	}
+
+	for _, tt := range tests {
+		for _, protection := range []types.Bool{types.BoolValue(true), types.BoolValue(false), types.BoolNull()} {
+			t.Run(tt.name+" "+protection.String(), func(t *testing.T) {
+				c := newFakeClient()
+				c.sources["source-1"] = &unstructured.Source{ID: "source-1", Name: "prod", Type: unstructured.ConnectorTypeS3, Config: &unstructured.S3SourceConnectorConfig{RemoteURL: "s3://prod/"}}
+				c.destinations["destination-1"] = &unstructured.Destination{ID: "destination-1", Name: "prod", Type: unstructured.ConnectorTypeS3, Config: &unstructured.S3DestinationConnectorConfig{RemoteURL: "s3://prod/"}}
+				c.workflows["workflow-1"] = &unstructured.Workflow{ID: "workflow-1", Name: "prod", Sources: []string{"source-1"}, Status: unstructured.WorkflowStateActive}
+
+				r := tt.resource()
+				_, diags := testCRUD(t, r, testResource(t, r, c), "Delete", tt.model(t, protection), nil)
+
+				protected := protection.ValueBool()
+
+				if got := diags.HasError() && strings.Contains(diags.Errors()[0].Summary(), "Deletion protection enabled"); got != protected {
+					t.Errorf("Delete() diagnostics = %v, want a deletion protection error: %t", diags, protected)
+				}
+
+				if tt.exists(c) != protected {
+					t.Errorf("Delete() kept the %s: %t, want %t", tt.name, tt.exists(c), protected)
+				}
+			})
+		}
+	}
+}
+
+func TestDeletionProtectionDefault(t *testing.T) {
+	c := newFakeClient()
+	c.sources["source-1"] = &unstructured.Source{ID: "source-1", Name: "prod", Type: unstructured.ConnectorTypeS3, Config: &unstructured.S3SourceConnectorConfig{RemoteURL: "s3://prod/"}}
+	protecting := &endpointClient{client: c, deletionProtection: true}
+
+	t.Run("read", func(t *testing.T) {
+		r := NewSourceResource()
+		empty := testResource(t, r, protecting)
+
+		for _, tt := range []struct {
+			prior types.Bool
+			want  bool
+		}{
+			{prior: types.BoolNull(), want: true},
+			{prior: types.BoolValue(false), want: false},
+		} {
+			prior := testSourceModel(t, "source-1", "prod", "s3://prod/")
+			prior.DeletionProtection = tt.prior
+
+			state, diags := testCRUD(t, r, empty, "Read", prior, nil)
+			if diags.HasError() {
+				t.Fatalf("Read() diagnostics = %v", diags)
+			}
+
+			var got resource_source.SourceModel
+			state.Get(t.Context(), &got)
+
+			if got.DeletionProtection.ValueBool() != tt.want {
+				t.Errorf("Read() with %s deletion_protection = %s, want %t", tt.prior, got.DeletionProtection, tt.want)
+			}
+		}
+	})
+
+	t.Run("plan", func(t *testing.T) {
+		for _, tt := range []struct {
+			name       string
+			resource   resource.ResourceWithModifyPlan
+			configured func(tftypes.Type) tftypes.Value
+			want       bool
+		}{
+			{name: "source default", resource: &sourceResource{client: protecting}, want: true},
+			{name: "source configured", resource: &sourceResource{client: protecting}, configured: testBool(false), want: false},
+			{name: "destination default", resource: &destinationResource{client: protecting}, want: true},
+			{name: "workflow default", resource: &workflowResource{client: protecting}, want: true},
+			{name: "workflow configured", resource: &workflowResource{client: protecting}, configured: testBool(false), want: false},
+		} {
+			t.Run(tt.name, func(t *testing.T) {
+				set := map[string]func(tftypes.Type) tftypes.Value{}
+				if tt.configured != nil {
+					set["deletion_protection"] = tt.configured
+				}
+
+				s, config := testObject(t, tt.resource, set)
+				_, prior := testObject(t, tt.resource, nil)
+
+				req := resource.ModifyPlanRequest{
+					Config: tfsdk.Config{Schema: s, Raw: config},
+					State:  tfsdk.State{Schema: s, Raw: prior},
+					Plan:   tfsdk.Plan{Schema: s, Raw: config},
+				}
+				resp := resource.ModifyPlanResponse{Plan: req.Plan}
+				tt.resource.ModifyPlan(t.Context(), req, &resp)
+
+				var got types.Bool
+				resp.Diagnostics.Append(resp.Plan.GetAttribute(t.Context(), path.Root("deletion_protection"), &got)...)
+				if resp.Diagnostics.HasError() {
+					t.Fatalf("ModifyPlan() diagnostics = %v", resp.Diagnostics)
+				}
+
+				if got.IsNull() || got.ValueBool() != tt.want {
+					t.Errorf("ModifyPlan() deletion_protection = %s, want %t", got, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("provider", func(t *testing.T) {
+		resp := testConfigureProvider(t, map[string]tftypes.Value{
+			"api_key":                     tftypes.NewValue(tftypes.String, "test-key"),
+			"skip_credentials_validation": tftypes.NewValue(tftypes.Bool, true),
+			"deletion_protection":         tftypes.NewValue(tftypes.Bool, true),
+		})
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("Configure() diagnostics = %v", resp.Diagnostics)
+		}
+
+		c, ok := resp.ResourceData.(client)
+		if !ok || !clientDeletionProtection(c) {
+			t.Errorf("Configure() resource data %T does not default to deletion protection", resp.ResourceData)
+		}
+	})
+}
+
+// testBool returns a bool value.
+func testBool(v bool) func(tftypes.Type) tftypes.Value {
+	return func(t tftypes.Type) tftypes.Value {
+		return tftypes.NewValue(t, v)
+	}
+}
@@ -4,20 +4,23 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/convert"
 	"github.com/aws-gopher/terraform-provider-unstructured/internal/datasource_destination"
 	"github.com/aws-gopher/unstructured-sdk-go"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 )
 
 var _ datasource.DataSource = (*destinationDataSource)(nil)
 var _ datasource.DataSourceWithConfigure = (*destinationDataSource)(nil)
+var _ datasource.DataSourceWithConfigValidators = (*destinationDataSource)(nil)
 
 func NewDestinationDataSource() datasource.DataSource {
 	return &destinationDataSource{}
 }
 
 type destinationDataSource struct {
-	client *unstructured.Client
+	client client
 }
 
 func (d *destinationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -33,16 +36,23 @@ func (d *destinationDataSource) Configure(ctx context.Context, req datasource.Co
 		return
 	}
 
-	client, ok := req.ProviderData.(*unstructured.Client)
+	c, ok := req.ProviderData.(client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *unstructured.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected an Unstructured API client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = c
+}
+
+// ConfigValidators implements datasource.DataSourceWithConfigValidators.
+func (d *destinationDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		idOrName(),
+	}
 }
 
 func (d *destinationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -55,16 +65,62 @@ func (d *destinationDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	// Get the destination by ID
-	destination, err := d.client.GetDestination(ctx, data.Id.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Error getting destination", err.Error())
+	// Get the destination by ID, or find it by name
+	destination := d.lookup(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Set state
-	resp.Diagnostics.Append(resp.State.Set(ctx, datasource_destination.DestinationToModel(ctx, destination, resp.Diagnostics))...)
+	model, diags := datasource_destination.DestinationToModel(ctx, destination)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// lookup gets the destination by ID, or finds it by name when no ID is set. Either
+// way, the destination must have the connector type set in data, if any.
+func (d *destinationDataSource) lookup(ctx context.Context, data *datasource_destination.DestinationModel, diags *diag.Diagnostics) *unstructured.Destination {
+	// The type may also be given as the name of a connector block
+	typ := data.Type.ValueString()
+	if t := convert.DestinationConnectorType(typ); t != "" {
+		typ = t
+	}
+
+	if !data.Id.IsNull() {
+		destination, err := d.client.GetDestination(ctx, data.Id.ValueString())
+		if err != nil {
+			diags.AddError("Error getting destination", err.Error())
+			return nil
+		}
+
+		if destination != nil {
+			checkConnectorType("destination", destination.ID, destination.Type, typ, diags)
+		}
+
+		return destination
+	}
+
+	// The API lists only the destinations of the configured connector type, if
+	// any
+	destinations, err := d.client.ListDestinations(ctx, typ)
+	if err != nil {
+		diags.AddError("Error listing destinations", err.Error())
+		return nil
+	}
+
+	kind := "destination"
+	if typ != "" {
+		kind = typ + " destination"
+	}
+
+	return findByName(kind, data.Name.ValueString(), destinations, func(destination *unstructured.Destination) (string, string) {
+		return destination.ID, destination.Name
+	}, diags)
 }
@@ -4,9 +4,9 @@
 package provider
 
 import (
-	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-testing/compare"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
@@ -16,26 +16,30 @@ import (
 func TestAccDestinationDataSource(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(t),
 		Steps: []resource.TestStep{
 			// Read testing
 			{
 				Config: testAccDestinationDataSourceConfig,
 				ConfigStateChecks: []statecheck.StateCheck{
-					statecheck.ExpectKnownValue(
+					statecheck.CompareValuePairs(
 						"data.unstructured_destination.test",
 						tfjsonpath.New("id"),
-						knownvalue.StringExact("1c684f00-0e7f-460b-ac31-21c062551997"),
+						"unstructured_destination.test",
+						tfjsonpath.New("id"),
+						compare.ValuesSame(),
 					),
 					statecheck.ExpectKnownValue(
 						"data.unstructured_destination.test",
 						tfjsonpath.New("name"),
-						knownvalue.StringFunc(func(v string) error {
-							if v == "" {
-								return fmt.Errorf("expected name to not be empty")
-							}
-							return nil
-						}),
+						knownvalue.StringExact("Terraform Test Destination Data Source"),
+					),
+					statecheck.CompareValuePairs(
+						"data.unstructured_destination.by_name",
+						tfjsonpath.New("id"),
+						"unstructured_destination.test",
+						tfjsonpath.New("id"),
+						compare.ValuesSame(),
 					),
 				},
 			},
@@ -44,7 +48,20 @@ func TestAccDestinationDataSource(t *testing.T) {
 }
 
 const testAccDestinationDataSourceConfig = `
+resource "unstructured_destination" "test" {
+  name = "Terraform Test Destination Data Source"
+
+  s3 = {
+    remote_url = "s3://example-bucket/output/"
+    anonymous  = true
+  }
+}
+
 data "unstructured_destination" "test" {
-  id = "1c684f00-0e7f-460b-ac31-21c062551997"
+  id = unstructured_destination.test.id
+}
+
+data "unstructured_destination" "by_name" {
+  name = unstructured_destination.test.name
 }
 `
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/convert"
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/resource_destination"
+	"github.com/aws-gopher/unstructured-sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ list.ListResource = (*destinationListResource)(nil)
+var _ list.ListResourceWithConfigure = (*destinationListResource)(nil)
+
+func NewDestinationListResource() list.ListResource {
+	return &destinationListResource{}
+}
+
+type destinationListResource struct {
+	client client
+}
+
+type destinationListResourceModel struct {
+	Type      types.String `tfsdk:"type"`
+	NameRegex types.String `tfsdk:"name_regex"`
+}
+
+func (r *destinationListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_destination"
+}
+
+func (r *destinationListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the destinations visible to the API key.",
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list destinations of this connector type, named as the API or as the connector block names it, such as `kafka-cloud` or `kafka_cloud`",
+				Validators: []validator.String{
+					stringvalidator.OneOf(connectorTypeFilters(resource_destination.DestinationConnectors, convert.DestinationConnectorType)...),
+				},
+			},
+			"name_regex": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list destinations whose name matches this regular expression",
+			},
+		},
+	}
+}
+
+func (r *destinationListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if c := configureListResource(req, resp); c != nil {
+		r.client = c
+	}
+}
+
+func (r *destinationListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data destinationListResourceModel
+	var diags diag.Diagnostics
+
+	// Read the list configuration into the model
+	diags.Append(req.Config.Get(ctx, &data)...)
+
+	re := nameRegex(data.NameRegex, &diags)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	destinations, unsupported, err := listDestinations(ctx, r.client, data.Type.ValueString(), re)
+	if err != nil {
+		diags.AddError("Error listing destinations", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	if len(unsupported) > 0 {
+		diags.AddWarning(
+			"Unsupported destination types",
+			"These destinations have connector types without a connector block of their own, so their config was read into the custom block: "+strings.Join(unsupported, ", "),
+		)
+	}
+
+	stream.Results = listResults(ctx, r.client, req, diags, destinations, func(s *unstructured.Destination) (string, string) {
+		return s.ID, s.Name
+	}, resource_destination.DestinationToModel)
+}
@@ -4,21 +4,27 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/convert"
 	"github.com/aws-gopher/terraform-provider-unstructured/internal/resource_destination"
 	"github.com/aws-gopher/unstructured-sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ resource.Resource = (*destinationResource)(nil)
 var _ resource.ResourceWithConfigure = (*destinationResource)(nil)
+var _ resource.ResourceWithIdentity = (*destinationResource)(nil)
+var _ resource.ResourceWithConfigValidators = (*destinationResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*destinationResource)(nil)
+var _ resource.ResourceWithImportState = (*destinationResource)(nil)
 
 func NewDestinationResource() resource.Resource {
 	return &destinationResource{}
 }
 
 type destinationResource struct {
-	client *unstructured.Client
+	client client
 }
 
 func (r *destinationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -26,7 +32,12 @@ func (r *destinationResource) Metadata(ctx context.Context, req resource.Metadat
 }
 
 func (r *destinationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = resource_destination.DestinationResourceSchema(ctx)
+	resp.Schema = withConnectorValidators(resource_destination.DestinationResourceSchema(ctx), connectorAttributeValidators)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (r *destinationResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema("destination")
 }
 
 func (r *destinationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -34,16 +45,88 @@ func (r *destinationResource) Configure(ctx context.Context, req resource.Config
 		return
 	}
 
-	client, ok := req.ProviderData.(*unstructured.Client)
+	c, ok := req.ProviderData.(client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *unstructured.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected an Unstructured API client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = c
+}
+
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+func (r *destinationResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		exactlyOneConnector(resource_destination.DestinationConnectors),
+		customConnectorValidator{kind: "destination", block: destinationConnectorBlock},
+	}
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+func (r *destinationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when destroying
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	planDeletionProtection(ctx, r.client, req, resp)
+
+	var plan resource_destination.DestinationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The connector type follows from the connector block
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("type"),
+		plannedConnectorType(resource_destination.DestinationConnectorBlocks(&plan), plan.Custom, convert.DestinationConnectorType))...)
+
+	// Nothing to replace when creating
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state resource_destination.DestinationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	requireConnectorReplace("destination",
+		withCustomConnector(resource_destination.DestinationConnectorBlocks(&state), state.Custom),
+		withCustomConnector(resource_destination.DestinationConnectorBlocks(&plan), plan.Custom),
+		resp)
+	requireCustomTypeReplace("destination", state.Custom, plan.Custom, resp)
+}
+
+// getDestinationConfig converts the Terraform model to the appropriate API config.
+func (r *destinationResource) getDestinationConfig(ctx context.Context, data *resource_destination.DestinationModel) (unstructured.DestinationConfigInput, diag.Diagnostics) {
+	if !data.Custom.IsNull() {
+		return customConfigInput(data.Custom), nil
+	}
+
+	config, diags := resource_destination.DestinationConfigInput(ctx, data)
+	if config == nil && !diags.HasError() {
+		diags.AddError("Error creating destination configuration", "no valid destination configuration found")
+	}
+
+	return config, diags
+}
+
+// carryOverUnstoredAttributes sets the attributes of model, as read from the
+// API, that the API does not store to their values in data, the planned or
+// prior state: the connection check and deletion protection settings, the
+// custom connector config while the API config still matches it, and the
+// connector attributes the API does not return.
+func (r *destinationResource) carryOverUnstoredAttributes(model, data *resource_destination.DestinationModel) {
+	model.CheckConnection = data.CheckConnection
+	model.CheckConnectionMode = data.CheckConnectionMode
+	model.DeletionProtection = data.DeletionProtection
+	model.Custom = keepCustomConfig(data.Custom, model.Custom)
+	resource_destination.KeepDestinationUnstoredAttributes(model, data)
 }
 
 func (r *destinationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -56,13 +139,41 @@ func (r *destinationResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	// Create API call logic
+	// Get the destination configuration
+	config, diags := r.getDestinationConfig(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	// Example data value setting
-	data.Id = types.StringValue("example-id")
+	// Create the destination
+	destination, err := r.client.CreateDestination(ctx, unstructured.CreateDestinationRequest{
+		Name:   data.Name.ValueString(),
+		Config: config,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating destination", err.Error())
+		return
+	}
 
 	// Save data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	model, diags := resource_destination.DestinationToModel(ctx, destination)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.carryOverUnstoredAttributes(model, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, r.client, model.Id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check the connection once the destination is in state, so that a failed check
+	// does not lose track of it
+	resp.Diagnostics.Append(checkConnection(ctx, "destination", model.Id.ValueString(), data.CheckConnection, data.CheckConnectionMode, r.client.CreateDestinationConnectionCheck, r.client.GetDestinationConnectionCheck)...)
 }
 
 func (r *destinationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -82,7 +193,21 @@ func (r *destinationResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, resource_destination.DestinationToModel(ctx, destination, resp.Diagnostics))...)
+	model, diags := resource_destination.DestinationToModel(ctx, destination)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(unsupportedConnectorWarning("destination", model.Id.ValueString(), data.Custom, model.Custom)...)
+
+	r.carryOverUnstoredAttributes(model, &data)
+
+	// State without the setting, as after an import, takes the provider default
+	model.DeletionProtection = deletionProtection(r.client, data.DeletionProtection)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	resp.Diagnostics.Append(keepIdentity(ctx, resp.Identity, r.client, model.Id)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -98,10 +223,47 @@ func (r *destinationResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	// Update API call logic
+	var state resource_destination.DestinationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the destination configuration
+	config, diags := r.getDestinationConfig(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update the destination
+	destination, err := r.client.UpdateDestination(ctx, unstructured.UpdateDestinationRequest{
+		ID:     state.Id.ValueString(),
+		Config: config,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating destination", err.Error())
+		return
+	}
 
 	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	model, diags := resource_destination.DestinationToModel(ctx, destination)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.carryOverUnstoredAttributes(model, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	resp.Diagnostics.Append(keepIdentity(ctx, resp.Identity, r.client, model.Id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check the connection once the destination is in state, so that a failed check
+	// does not lose track of it
+	resp.Diagnostics.Append(checkConnection(ctx, "destination", model.Id.ValueString(), data.CheckConnection, data.CheckConnectionMode, r.client.CreateDestinationConnectionCheck, r.client.GetDestinationConnectionCheck)...)
 }
 
 func (r *destinationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -114,6 +276,12 @@ func (r *destinationResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	// A protected destination is kept until deletion_protection is turned off
+	resp.Diagnostics.Append(checkDeletionProtection("destination", data.Id.ValueString(), data.DeletionProtection)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Delete API call logic
 	err := r.client.DeleteDestination(ctx, data.Id.ValueString())
 	if err != nil {
@@ -121,3 +289,32 @@ func (r *destinationResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 }
+
+// ImportState implements resource.ResourceWithImportState.
+func (r *destinationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by ID, or by the id of the import identity
+	id := importID(ctx, "destination", r.client, req, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	destination, err := r.client.GetDestination(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing destination", err.Error())
+		return
+	}
+
+	model, diags := resource_destination.DestinationToModel(ctx, destination)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(unsupportedConnectorWarning("destination", model.Id.ValueString(), convert.CustomConnectorValue{}, model.Custom)...)
+
+	// Imported destinations follow the provider default until configured otherwise
+	model.DeletionProtection = deletionProtection(r.client, model.DeletionProtection)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, r.client, model.Id)...)
+}
@@ -0,0 +1,219 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/resource_destination"
+	"github.com/aws-gopher/unstructured-sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testDestinationModel(t *testing.T, id, name, remoteURL string) *resource_destination.DestinationModel {
+	t.Helper()
+
+	model := &resource_destination.DestinationModel{
+		Id:   types.StringNull(),
+		Name: types.StringValue(name),
+	}
+
+	if id != "" {
+		model.Id = types.StringValue(id)
+	}
+
+	if remoteURL != "" {
+		model.S3 = resource_destination.NewS3ValueMust(resource_destination.S3Value{}.AttributeTypes(t.Context()), map[string]attr.Value{
+			"remote_url":   types.StringValue(remoteURL),
+			"anonymous":    types.BoolNull(),
+			"recursive":    types.BoolNull(),
+			"endpoint_url": types.StringNull(),
+			"key":          types.StringValue("access-key"),
+			"secret":       types.StringValue("secret-key"),
+			"token":        types.StringNull(),
+		})
+	}
+
+	return model
+}
+
+func TestDestinationResourceCRUD(t *testing.T) {
+	seed := func(c *fakeClient) {
+		c.destinations["destination-1"] = &unstructured.Destination{
+			ID:     "destination-1",
+			Name:   "existing",
+			Type:   unstructured.ConnectorTypeS3,
+			Config: &unstructured.S3DestinationConnectorConfig{RemoteURL: "s3://existing/"},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		op      string
+		seed    func(*fakeClient)
+		err     error
+		prior   func(t *testing.T) *resource_destination.DestinationModel
+		planned func(t *testing.T) *resource_destination.DestinationModel
+		wantErr string
+		check   func(t *testing.T, c *fakeClient, state resource_destination.DestinationModel)
+	}{
+		{
+			name: "create",
+			op:   "Create",
+			planned: func(t *testing.T) *resource_destination.DestinationModel {
+				return testDestinationModel(t, "", "new", "s3://bucket/output/")
+			},
+			check: func(t *testing.T, c *fakeClient, state resource_destination.DestinationModel) {
+				config, ok := c.lastDestinationConfig.(*unstructured.S3DestinationConnectorConfigInput)
+				if !ok || config.RemoteURL != "s3://bucket/output/" || config.Key == nil || *config.Key != "access-key" {
+					t.Errorf("CreateDestination() config = %+v", c.lastDestinationConfig)
+				}
+
+				if state.Id.ValueString() != "destination-1" || state.Name.ValueString() != "new" {
+					t.Errorf("state = %s %s, want destination-1 new", state.Id, state.Name)
+				}
+			},
+		},
+		{
+			name: "create without a connector block",
+			op:   "Create",
+			planned: func(t *testing.T) *resource_destination.DestinationModel {
+				return testDestinationModel(t, "", "new", "")
+			},
+			wantErr: "Error creating destination configuration",
+		},
+		{
+			name: "create API error",
+			op:   "Create",
+			err:  errors.New("boom"),
+			planned: func(t *testing.T) *resource_destination.DestinationModel {
+				return testDestinationModel(t, "", "new", "s3://bucket/output/")
+			},
+			wantErr: "Error creating destination",
+		},
+		{
+			name: "read",
+			op:   "Read",
+			seed: seed,
+			prior: func(t *testing.T) *resource_destination.DestinationModel {
+				return testDestinationModel(t, "destination-1", "stale", "s3://existing/")
+			},
+			check: func(t *testing.T, c *fakeClient, state resource_destination.DestinationModel) {
+				if state.Name.ValueString() != "existing" {
+					t.Errorf("state name = %s, want existing", state.Name)
+				}
+			},
+		},
+		{
+			name: "read missing",
+			op:   "Read",
+			prior: func(t *testing.T) *resource_destination.DestinationModel {
+				return testDestinationModel(t, "destination-1", "stale", "s3://existing/")
+			},
+			wantErr: "Error getting destination",
+		},
+		{
+			name: "update",
+			op:   "Update",
+			seed: seed,
+			prior: func(t *testing.T) *resource_destination.DestinationModel {
+				return testDestinationModel(t, "destination-1", "existing", "s3://existing/")
+			},
+			planned: func(t *testing.T) *resource_destination.DestinationModel {
+				return testDestinationModel(t, "destination-1", "existing", "s3://updated/")
+			},
+			check: func(t *testing.T, c *fakeClient, state resource_destination.DestinationModel) {
+				if config, _ := c.destinations["destination-1"].Config.(*unstructured.S3DestinationConnectorConfig); config == nil || config.RemoteURL != "s3://updated/" {
+					t.Errorf("destination config after update = %+v", c.destinations["destination-1"].Config)
+				}
+
+				if state.Id.ValueString() != "destination-1" {
+					t.Errorf("state id = %s, want destination-1", state.Id)
+				}
+			},
+		},
+		{
+			name: "update API error",
+			op:   "Update",
+			seed: seed,
+			err:  errors.New("boom"),
+			prior: func(t *testing.T) *resource_destination.DestinationModel {
+				return testDestinationModel(t, "destination-1", "existing", "s3://existing/")
+			},
+			planned: func(t *testing.T) *resource_destination.DestinationModel {
+				return testDestinationModel(t, "destination-1", "existing", "s3://updated/")
+			},
+			wantErr: "Error updating destination",
+		},
+		{
+			name: "delete",
+			op:   "Delete",
+			seed: seed,
+			prior: func(t *testing.T) *resource_destination.DestinationModel {
+				return testDestinationModel(t, "destination-1", "existing", "s3://existing/")
+			},
+			check: func(t *testing.T, c *fakeClient, _ resource_destination.DestinationModel) {
+				if _, ok := c.destinations["destination-1"]; ok {
+					t.Error("destination was not deleted")
+				}
+			},
+		},
+		{
+			name: "delete missing",
+			op:   "Delete",
+			prior: func(t *testing.T) *resource_destination.DestinationModel {
+				return testDestinationModel(t, "destination-1", "existing", "s3://existing/")
+			},
+			wantErr: "Error deleting destination",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newFakeClient()
+			if tt.seed != nil {
+				tt.seed(c)
+			}
+			c.err = tt.err
+
+			r := NewDestinationResource()
+			empty := testResource(t, r, c)
+
+			var prior, planned any
+			if tt.prior != nil {
+				prior = tt.prior(t)
+			}
+			if tt.planned != nil {
+				planned = tt.planned(t)
+			}
+
+			state, diags := testCRUD(t, r, empty, tt.op, prior, planned)
+			if tt.wantErr != "" {
+				if !diags.HasError() || !strings.Contains(fmt.Sprint(diags), tt.wantErr) {
+					t.Fatalf("%s() diagnostics = %v, want %q", tt.op, diags, tt.wantErr)
+				}
+				return
+			}
+
+			if diags.HasError() {
+				t.Fatalf("%s() diagnostics = %v", tt.op, diags)
+			}
+
+			var got resource_destination.DestinationModel
+			if !state.Raw.IsNull() {
+				if diags := state.Get(t.Context(), &got); diags.HasError() {
+					t.Fatalf("State.Get() diagnostics = %v", diags)
+				}
+			}
+
+			if tt.check != nil {
+				tt.check(t, c, got)
+			}
+		})
+	}
+}
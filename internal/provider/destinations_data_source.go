@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/convert"
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/datasource_destination"
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/resource_destination"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*destinationsDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*destinationsDataSource)(nil)
+
+func NewDestinationsDataSource() datasource.DataSource {
+	return &destinationsDataSource{}
+}
+
+type destinationsDataSource struct {
+	client client
+}
+
+type destinationsDataSourceModel struct {
+	Type         types.String `tfsdk:"type"`
+	NameRegex    types.String `tfsdk:"name_regex"`
+	Destinations types.List   `tfsdk:"destinations"`
+}
+
+func destinationsDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description: "Lists the destinations visible to the API key.",
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list destinations of this connector type, named as the API or as the connector block names it, such as `kafka-cloud` or `kafka_cloud`",
+				Validators: []validator.String{
+					stringvalidator.OneOf(connectorTypeFilters(resource_destination.DestinationConnectors, convert.DestinationConnectorType)...),
+				},
+			},
+			"name_regex": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list destinations whose name matches this regular expression",
+			},
+			"destinations": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The matching destinations, with the attributes of the `unstructured_destination` data source",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: listElementAttributes(datasource_destination.DestinationDataSourceSchema(ctx)),
+				},
+			},
+		},
+	}
+}
+
+func (d *destinationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_destinations"
+}
+
+func (d *destinationsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = destinationsDataSourceSchema(ctx)
+}
+
+func (d *destinationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected an Unstructured API client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *destinationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data destinationsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	re := nameRegex(data.NameRegex, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	destinations, unsupported, err := listDestinations(ctx, d.client, data.Type.ValueString(), re)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing destinations", err.Error())
+		return
+	}
+
+	if len(unsupported) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Unsupported destination types",
+			"These destinations have connector types without a connector block of their own, so only their config_json is set: "+strings.Join(unsupported, ", "),
+		)
+	}
+
+	objs := make([]convert.Object, 0, len(destinations))
+	for i := range destinations {
+		obj, _ := convert.Destination(&destinations[i])
+		objs = append(objs, obj)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(convert.Project(ctx, convert.Object{
+		"type":         data.Type.ValueStringPointer(),
+		"name_regex":   data.NameRegex.ValueStringPointer(),
+		"destinations": objs,
+	}, destinationsDataSourceSchema(ctx).Type(), &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Destinations = emptyIfNull(ctx, data.Destinations)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,528 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"math/big"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/convert"
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/resource_destination"
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/resource_source"
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/resource_workflow"
+	"github.com/aws-gopher/unstructured-sdk-go"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zclconf/go-cty/cty"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+)
+
+// ExportConfig configures Export. The API key and any unset endpoint are
+// resolved like the provider does, from the environment and the shared
+// credentials file.
+type ExportConfig struct {
+	Endpoint        string
+	Profile         string
+	CredentialsFile string
+
+	// Dir is the directory the configuration is written to. It is created
+	// if it does not exist.
+	Dir string
+}
+
+// Export writes the sources, destinations and workflows visible to the API
+// key as Terraform configuration in cfg.Dir, with an import block for each.
+// Objects that cannot be exported are reported to warnings and skipped.
+func Export(ctx context.Context, version string, cfg ExportConfig, warnings io.Writer) error {
+	creds, err := resolveCredentials(ctx, ProviderModel{
+		Endpoint:        types.StringValue(cfg.Endpoint),
+		Profile:         types.StringValue(cfg.Profile),
+		CredentialsFile: types.StringValue(cfg.CredentialsFile),
+	})
+	if err != nil {
+		return err
+	}
+
+	if creds.APIKey == "" {
+		return fmt.Errorf("no API key found in the %s environment variable or the shared credentials file", envAPIKey)
+	}
+
+	hc, err := newHTTPClient(transportConfig{UserAgent: userAgent(version, "")})
+	if err != nil {
+		return err
+	}
+
+	opts := []unstructured.Option{unstructured.WithClient(hc), unstructured.WithKey(creds.APIKey)}
+
+	if creds.Endpoint != "" {
+		if err := validateEndpoint(creds.Endpoint); err != nil {
+			return err
+		}
+
+		opts = append(opts, unstructured.WithEndpoint(creds.Endpoint))
+	}
+
+	c, err := unstructured.New(opts...)
+	if err != nil {
+		return err
+	}
+
+	files, skipped, err := exportFiles(ctx, c, creds.Endpoint)
+	if err != nil {
+		return err
+	}
+
+	for _, s := range skipped {
+		fmt.Fprintf(warnings, "Skipped %s\n", s)
+	}
+
+	return writeFiles(cfg.Dir, files)
+}
+
+// writeFiles writes files to dir, refusing to overwrite existing files.
+func writeFiles(dir string, files map[string][]byte) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	names := slices.Sorted(maps.Keys(files))
+
+	for _, name := range names {
+		if _, err := os.Stat(filepath.Join(dir, name)); !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("refusing to overwrite %s", filepath.Join(dir, name))
+		}
+	}
+
+	for _, name := range names {
+		if err := os.WriteFile(filepath.Join(dir, name), files[name], 0o644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// exportFiles returns the exported configuration by file name, and the
+// objects that were skipped. endpoint, if set, is written to the provider
+// block.
+func exportFiles(ctx context.Context, c client, endpoint string) (map[string][]byte, []string, error) {
+	e := &exporter{
+		labels:    map[string]map[string]bool{},
+		addresses: map[string]hcl.Traversal{},
+		variables: hclwrite.NewEmptyFile(),
+	}
+
+	// Sources and destinations of unsupported types are exported with custom
+	// connector blocks
+	sources, err := c.ListSources(ctx, "")
+	if err != nil {
+		return nil, nil, fmt.Errorf("listing sources: %w", err)
+	}
+
+	destinations, err := c.ListDestinations(ctx, "")
+	if err != nil {
+		return nil, nil, fmt.Errorf("listing destinations: %w", err)
+	}
+
+	workflows, err := listAllWorkflows(ctx, c, unstructured.ListWorkflowsRequest{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("listing workflows: %w", err)
+	}
+
+	// Sources and destinations go first, so that workflows can refer to them
+	sourcesFile := hclwrite.NewEmptyFile()
+	for i := range sources {
+		model, diags := resource_source.SourceToModel(ctx, &sources[i])
+		e.resource(ctx, sourcesFile.Body(), NewSourceResource(), resource_source.SourceConnectors, "source", sources[i].ID, sources[i].Name, model, diags)
+	}
+
+	destinationsFile := hclwrite.NewEmptyFile()
+	for i := range destinations {
+		model, diags := resource_destination.DestinationToModel(ctx, &destinations[i])
+		e.resource(ctx, destinationsFile.Body(), NewDestinationResource(), resource_destination.DestinationConnectors, "destination", destinations[i].ID, destinations[i].Name, model, diags)
+	}
+
+	workflowsFile := hclwrite.NewEmptyFile()
+	for i := range workflows {
+		model, diags := resource_workflow.WorkflowToModel(ctx, &workflows[i])
+		e.resource(ctx, workflowsFile.Body(), NewWorkflowResource(), nil, "workflow", workflows[i].ID, workflows[i].Name, model, diags)
+	}
+
+	files := map[string][]byte{
+		"provider.tf": hclwrite.Format(providerFile(endpoint).Bytes()),
+	}
+
+	for name, f := range map[string]*hclwrite.File{
+		"sources.tf":      sourcesFile,
+		"destinations.tf": destinationsFile,
+		"workflows.tf":    workflowsFile,
+		"variables.tf":    e.variables,
+	} {
+		if len(f.Body().Blocks()) > 0 {
+			files[name] = hclwrite.Format(f.Bytes())
+		}
+	}
+
+	return files, e.skipped, nil
+}
+
+// providerFile returns the terraform and provider blocks of the exported
+// configuration.
+func providerFile(endpoint string) *hclwrite.File {
+	f := hclwrite.NewEmptyFile()
+
+	providers := f.Body().AppendNewBlock("terraform", nil).Body().AppendNewBlock("required_providers", nil).Body()
+	providers.SetAttributeValue("unstructured", cty.ObjectVal(map[string]cty.Value{
+		"source": cty.StringVal("aws-gopher/unstructured"),
+	}))
+
+	f.Body().AppendNewline()
+
+	provider := f.Body().AppendNewBlock("provider", []string{"unstructured"}).Body()
+	if endpoint != "" {
+		provider.SetAttributeValue("endpoint", cty.StringVal(endpoint))
+	}
+
+	return f
+}
+
+// exporter writes resources, keeping track of their addresses and the
+// variables that replace their secrets.
+type exporter struct {
+	// labels are the resource labels in use, by resource type.
+	labels map[string]map[string]bool
+
+	// addresses are the addresses of the exported resources, by API ID.
+	addresses map[string]hcl.Traversal
+
+	variables *hclwrite.File
+	skipped   []string
+}
+
+// resource writes an import block and a resource block for an API object,
+// given the resource model its converter returned and the names of the
+// connector attributes of the resource. Objects that could not be converted
+// are skipped.
+func (e *exporter) resource(ctx context.Context, body *hclwrite.Body, r resource.Resource, connectors []string, kind, id, name string, model any, diags diag.Diagnostics) {
+	if diags.HasError() {
+		e.skipped = append(e.skipped, fmt.Sprintf("%s %s (%s): %s", kind, id, name, diagSummary(diags)))
+		return
+	}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+
+	if diags := state.Set(ctx, model); diags.HasError() {
+		e.skipped = append(e.skipped, fmt.Sprintf("%s %s (%s): %s", kind, id, name, diagSummary(diags)))
+		return
+	}
+
+	typ := "unstructured_" + kind
+	label := e.label(typ, kind, name)
+	address := hcl.Traversal{hcl.TraverseRoot{Name: typ}, hcl.TraverseAttr{Name: label}}
+	e.addresses[id] = address
+
+	if len(body.Blocks()) > 0 {
+		body.AppendNewline()
+	}
+
+	imp := body.AppendNewBlock("import", nil).Body()
+	imp.SetAttributeTraversal("to", address)
+	imp.SetAttributeValue("id", cty.StringVal(id))
+
+	body.AppendNewline()
+
+	block := body.AppendNewBlock("resource", []string{typ, label}).Body()
+	for _, attr := range e.attributes(schemaResp.Schema.Attributes, state.Raw, variablePrefix{kind: kind, label: label, name: name, connectors: connectors}) {
+		// Set nested objects apart from the attributes before them
+		if attr.nested {
+			block.AppendNewline()
+		}
+
+		block.SetAttributeRaw(attr.name, attr.tokens)
+	}
+}
+
+// diagSummary returns the summaries of the errors in diags.
+func diagSummary(diags diag.Diagnostics) string {
+	var summaries []string
+	for _, d := range diags.Errors() {
+		summaries = append(summaries, d.Summary())
+	}
+
+	return strings.Join(summaries, ", ")
+}
+
+var labelInvalid = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// label returns a unique resource label of the given type for an object
+// name.
+func (e *exporter) label(typ, kind, name string) string {
+	base := strings.Trim(labelInvalid.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if base == "" || (base[0] >= '0' && base[0] <= '9') {
+		base = strings.TrimSuffix(kind+"_"+base, "_")
+	}
+
+	if e.labels[typ] == nil {
+		e.labels[typ] = map[string]bool{}
+	}
+
+	label := base
+	for i := 2; e.labels[typ][label]; i++ {
+		label = fmt.Sprintf("%s_%d", base, i)
+	}
+
+	e.labels[typ][label] = true
+
+	return label
+}
+
+// variablePrefix identifies the resource whose secrets are replaced with
+// variables.
+type variablePrefix struct {
+	kind, label, name string
+	connectors        []string
+
+	// connector is the connector attribute being written, if any.
+	connector string
+}
+
+// exportAttribute is an attribute of an exported object.
+type exportAttribute struct {
+	name   string
+	tokens hclwrite.Tokens
+	nested bool
+}
+
+// attributes returns the attributes of v that a configuration sets, in the
+// order they are written: name first, then alphabetically. Computed-only and
+// null attributes are left out, and IDs of exported resources are replaced
+// with references to them.
+func (e *exporter) attributes(attrs map[string]schema.Attribute, v tftypes.Value, prefix variablePrefix) []exportAttribute {
+	var values map[string]tftypes.Value
+	if err := v.As(&values); err != nil {
+		return nil
+	}
+
+	names := slices.SortedFunc(maps.Keys(attrs), func(a, b string) int {
+		switch {
+		case a == b:
+			return 0
+		case a == "name":
+			return -1
+		case b == "name":
+			return 1
+		}
+
+		return strings.Compare(a, b)
+	})
+
+	var out []exportAttribute
+
+	for _, name := range names {
+		attr := attrs[name]
+		value := values[name]
+
+		if attr.IsComputed() && !attr.IsOptional() && !attr.IsRequired() {
+			continue
+		}
+
+		if prefix.connector != "" && convert.SecretAttributes[name] && (!value.IsNull() || attr.IsRequired()) {
+			out = append(out, exportAttribute{name: name, tokens: e.variable(prefix, name)})
+			continue
+		}
+
+		if value.IsNull() {
+			continue
+		}
+
+		_, nested := attr.(schema.NestedAttribute)
+		out = append(out, exportAttribute{name: name, tokens: e.value(attr, value, prefix, name), nested: nested})
+	}
+
+	return out
+}
+
+// value returns the tokens of an attribute value.
+func (e *exporter) value(attr schema.Attribute, v tftypes.Value, prefix variablePrefix, name string) hclwrite.Tokens {
+	switch attr := attr.(type) {
+	case schema.SingleNestedAttribute:
+		if slices.Contains(prefix.connectors, name) {
+			prefix.connector = name
+		}
+
+		// Name the secrets of custom connectors after their connector type
+		if name == "custom" {
+			var attrs map[string]tftypes.Value
+			_ = v.As(&attrs)
+			_ = attrs["type"].As(&prefix.connector)
+		}
+
+		return e.object(attr.Attributes, v, prefix)
+
+	case schema.ListNestedAttribute:
+		var elems []tftypes.Value
+		if err := v.As(&elems); err != nil {
+			return nil
+		}
+
+		tuple := make([]hclwrite.Tokens, len(elems))
+		for i := range elems {
+			tuple[i] = e.object(attr.NestedObject.Attributes, elems[i], prefix)
+		}
+
+		return hclwrite.TokensForTuple(tuple)
+
+	case schema.StringAttribute:
+		var s string
+		if err := v.As(&s); err == nil && strings.HasSuffix(name, "_id") && e.addresses[s] != nil {
+			return hclwrite.TokensForTraversal(append(slices.Clone(e.addresses[s]), hcl.TraverseAttr{Name: "id"}))
+		}
+
+		if prefix.connector != "" && name == "config_json" {
+			if tokens := e.configJSON(s, prefix); tokens != nil {
+				return tokens
+			}
+		}
+	}
+
+	return hclwrite.TokensForValue(ctyValue(v))
+}
+
+// object returns the tokens of a nested object.
+func (e *exporter) object(attrs map[string]schema.Attribute, v tftypes.Value, prefix variablePrefix) hclwrite.Tokens {
+	var tokens []hclwrite.ObjectAttrTokens
+	for _, attr := range e.attributes(attrs, v, prefix) {
+		tokens = append(tokens, hclwrite.ObjectAttrTokens{
+			Name:  hclwrite.TokensForIdentifier(attr.name),
+			Value: attr.tokens,
+		})
+	}
+
+	return hclwrite.TokensForObject(tokens)
+}
+
+// configJSON returns the tokens of a jsonencode call for the config JSON
+// object of a custom connector, with its secrets replaced with variables. It
+// returns nil if config is not a JSON object.
+func (e *exporter) configJSON(config string, prefix variablePrefix) hclwrite.Tokens {
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(config), &obj); err != nil || obj == nil {
+		return nil
+	}
+
+	var tokens []hclwrite.ObjectAttrTokens
+	for _, name := range slices.Sorted(maps.Keys(obj)) {
+		var value hclwrite.Tokens
+		if convert.SecretAttributes[name] && string(obj[name]) != "null" {
+			value = e.variable(prefix, name)
+		} else {
+			typ, err := ctyjson.ImpliedType(obj[name])
+			if err != nil {
+				return nil
+			}
+
+			v, err := ctyjson.Unmarshal(obj[name], typ)
+			if err != nil {
+				return nil
+			}
+
+			value = hclwrite.TokensForValue(v)
+		}
+
+		tokens = append(tokens, hclwrite.ObjectAttrTokens{
+			Name:  hclwrite.TokensForValue(cty.StringVal(name)),
+			Value: value,
+		})
+	}
+
+	return hclwrite.TokensForFunctionCall("jsonencode", hclwrite.TokensForObject(tokens))
+}
+
+// variable declares a variable for a secret connector attribute and returns
+// a reference to it.
+func (e *exporter) variable(prefix variablePrefix, attr string) hclwrite.Tokens {
+	name := strings.Join([]string{prefix.kind, prefix.label, attr}, "_")
+
+	body := e.variables.Body()
+	if len(body.Blocks()) > 0 {
+		body.AppendNewline()
+	}
+
+	block := body.AppendNewBlock("variable", []string{name}).Body()
+	block.SetAttributeValue("description", cty.StringVal(fmt.Sprintf("The %s of the %s %s %q", attr, prefix.connector, prefix.kind, prefix.name)))
+	block.SetAttributeTraversal("type", hcl.Traversal{hcl.TraverseRoot{Name: "string"}})
+	block.SetAttributeValue("sensitive", cty.True)
+
+	return hclwrite.TokensForTraversal(hcl.Traversal{hcl.TraverseRoot{Name: "var"}, hcl.TraverseAttr{Name: name}})
+}
+
+// ctyValue converts a known value of a primitive or collection type.
+func ctyValue(v tftypes.Value) cty.Value {
+	typ := v.Type()
+
+	switch {
+	case v.IsNull():
+		return cty.NullVal(cty.DynamicPseudoType)
+
+	case typ.Is(tftypes.String):
+		var s string
+		_ = v.As(&s)
+		return cty.StringVal(s)
+
+	case typ.Is(tftypes.Bool):
+		var b bool
+		_ = v.As(&b)
+		return cty.BoolVal(b)
+
+	case typ.Is(tftypes.Number):
+		n := new(big.Float)
+		_ = v.As(&n)
+		return cty.NumberVal(n)
+
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var elems []tftypes.Value
+		_ = v.As(&elems)
+
+		vals := make([]cty.Value, len(elems))
+		for i := range elems {
+			vals[i] = ctyValue(elems[i])
+		}
+
+		return cty.TupleVal(vals)
+
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		var attrs map[string]tftypes.Value
+		_ = v.As(&attrs)
+
+		vals := make(map[string]cty.Value, len(attrs))
+		for name := range attrs {
+			if !attrs[name].IsNull() {
+				vals[name] = ctyValue(attrs[name])
+			}
+		}
+
+		return cty.ObjectVal(vals)
+	}
+
+	return cty.NullVal(cty.DynamicPseudoType)
+}
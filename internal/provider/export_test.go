@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/resource_source"
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/resource_workflow"
+	"github.com/aws-gopher/unstructured-sdk-go"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/function"
+	"github.com/zclconf/go-cty/cty/function/stdlib"
+)
+
+func testExportClient() *fakeClient {
+	basic := unstructured.WorkflowTypeBasic
+	key, secret := "AKIAEXAMPLE", "s3cr3t"
+
+	c := newFakeClient()
+	c.sources["source-1"] = &unstructured.Source{ID: "source-1", Name: "Prod S3", Type: unstructured.ConnectorTypeS3, Config: &unstructured.S3SourceConnectorConfig{RemoteURL: "s3://prod/", Key: &key, Secret: &secret, Recursive: true}}
+	c.sources["source-2"] = &unstructured.Source{ID: "source-2", Name: "prod-s3", Type: unstructured.ConnectorTypeS3, Config: &unstructured.S3SourceConnectorConfig{RemoteURL: "s3://other/", Anonymous: true}}
+	c.sources["source-3"] = &unstructured.Source{ID: "source-3", Name: "team-slack", Type: unstructured.ConnectorTypeSlack, Config: &unstructured.SlackSourceConnectorConfig{Channels: []string{"C123"}, Token: "xoxb-t0k3n"}}
+	c.destinations["destination-1"] = &unstructured.Destination{ID: "destination-1", Name: "2024 archive", Type: unstructured.ConnectorTypeS3, Config: &unstructured.S3DestinationConnectorConfig{RemoteURL: "s3://archive/", Anonymous: true}}
+	c.workflows["workflow-1"] = &unstructured.Workflow{ID: "workflow-1", Name: "ingest", WorkflowType: &basic, Sources: []string{"source-1"}, Destinations: []string{"destination-1"}, Status: unstructured.WorkflowStateActive}
+	c.workflows["workflow-2"] = &unstructured.Workflow{ID: "workflow-2", Name: "orphan", WorkflowType: &basic, Sources: []string{"source-3"}, Status: unstructured.WorkflowStateInactive}
+
+	return c
+}
+
+func TestExportFiles(t *testing.T) {
+	files, skipped, err := exportFiles(t.Context(), testExportClient(), "https://api.example.com/api/v1")
+	if err != nil {
+		t.Fatalf("exportFiles() error = %v", err)
+	}
+
+	if len(skipped) > 0 {
+		t.Errorf("exportFiles() skipped = %s", strings.Join(skipped, "; "))
+	}
+
+	var names []string
+	for name := range files {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	if got := strings.Join(names, " "); got != "destinations.tf provider.tf sources.tf variables.tf workflows.tf" {
+		t.Fatalf("exportFiles() files = %s", got)
+	}
+
+	for name, want := range map[string][]string{
+		"provider.tf": {
+			`source = "aws-gopher/unstructured"`,
+			`endpoint = "https://api.example.com/api/v1"`,
+		},
+		"sources.tf": {
+			"import {\n  to = unstructured_source.prod_s3\n  id = \"source-1\"\n}",
+			`resource "unstructured_source" "prod_s3" {`,
+			`resource "unstructured_source" "prod_s3_2" {`,
+			`secret     = var.source_prod_s3_secret`,
+			`key        = var.source_prod_s3_key`,
+			`resource "unstructured_source" "team_slack" {`,
+			`type = "slack"`,
+			`"token"    = var.source_team_slack_token`,
+		},
+		"destinations.tf": {
+			`resource "unstructured_destination" "destination_2024_archive" {`,
+		},
+		"workflows.tf": {
+			`source_id      = unstructured_source.prod_s3.id`,
+			`destination_id = unstructured_destination.destination_2024_archive.id`,
+			`source_id     = unstructured_source.team_slack.id`,
+		},
+		"variables.tf": {
+			`variable "source_prod_s3_secret" {`,
+			`description = "The secret of the s3 source \"Prod S3\""`,
+			`sensitive   = true`,
+			`description = "The token of the slack source \"team-slack\""`,
+		},
+	} {
+		for _, s := range want {
+			if !strings.Contains(string(files[name]), s) {
+				t.Errorf("%s does not contain %q:\n%s", name, s, files[name])
+			}
+		}
+	}
+
+	for name, b := range files {
+		if strings.Contains(string(b), "s3cr3t") || strings.Contains(string(b), "AKIAEXAMPLE") || strings.Contains(string(b), "xoxb-t0k3n") {
+			t.Errorf("%s contains a secret:\n%s", name, b)
+		}
+
+		if _, diags := hclsyntax.ParseConfig(b, name, hcl.InitialPos); diags.HasErrors() {
+			t.Errorf("%s does not parse: %s", name, diags)
+		}
+	}
+}
+
+// TestExportMatchesState checks that the exported attributes evaluate to the
+// state the resources would read, so that the exported configuration plans
+// without changes.
+func TestExportMatchesState(t *testing.T) {
+	ctx := t.Context()
+	c := testExportClient()
+
+	files, _, err := exportFiles(ctx, c, "")
+	if err != nil {
+		t.Fatalf("exportFiles() error = %v", err)
+	}
+
+	eval := &hcl.EvalContext{
+		Functions: map[string]function.Function{"jsonencode": stdlib.JSONEncodeFunc},
+		Variables: map[string]cty.Value{
+			"var": cty.ObjectVal(map[string]cty.Value{
+				"source_prod_s3_key":      cty.StringVal("AKIAEXAMPLE"),
+				"source_prod_s3_secret":   cty.StringVal("s3cr3t"),
+				"source_team_slack_token": cty.StringVal("xoxb-t0k3n"),
+			}),
+			"unstructured_source": cty.ObjectVal(map[string]cty.Value{
+				"prod_s3":    cty.ObjectVal(map[string]cty.Value{"id": cty.StringVal("source-1")}),
+				"team_slack": cty.ObjectVal(map[string]cty.Value{"id": cty.StringVal("source-3")}),
+			}),
+			"unstructured_destination": cty.ObjectVal(map[string]cty.Value{
+				"destination_2024_archive": cty.ObjectVal(map[string]cty.Value{"id": cty.StringVal("destination-1")}),
+			}),
+		},
+	}
+
+	source, _ := resource_source.SourceToModel(ctx, c.sources["source-1"])
+	custom, _ := resource_source.SourceToModel(ctx, c.sources["source-3"])
+	workflow, _ := resource_workflow.WorkflowToModel(ctx, c.workflows["workflow-1"])
+
+	tests := []struct {
+		file, label string
+		r           resource.Resource
+		model       any
+	}{
+		{file: "sources.tf", label: "prod_s3", r: NewSourceResource(), model: source},
+		{file: "sources.tf", label: "team_slack", r: NewSourceResource(), model: custom},
+		{file: "workflows.tf", label: "ingest", r: NewWorkflowResource(), model: workflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.label, func(t *testing.T) {
+			f, diags := hclsyntax.ParseConfig(files[tt.file], tt.file, hcl.InitialPos)
+			if diags.HasErrors() {
+				t.Fatalf("ParseConfig() diagnostics = %s", diags)
+			}
+
+			var block *hclsyntax.Block
+			for _, b := range f.Body.(*hclsyntax.Body).Blocks {
+				if b.Type == "resource" && b.Labels[1] == tt.label {
+					block = b
+				}
+			}
+
+			if block == nil {
+				t.Fatalf("%s has no resource %s", tt.file, tt.label)
+			}
+
+			state := testResource(t, tt.r, nil)
+			if diags := state.Set(ctx, tt.model); diags.HasError() {
+				t.Fatalf("State.Set() diagnostics = %v", diags)
+			}
+
+			var values map[string]tftypes.Value
+			if err := state.Raw.As(&values); err != nil {
+				t.Fatal(err)
+			}
+
+			for name, attr := range block.Body.Attributes {
+				got, diags := attr.Expr.Value(eval)
+				if diags.HasErrors() {
+					t.Fatalf("%s: %s", name, diags)
+				}
+
+				if want := ctyValue(values[name]); !got.Equals(want).True() {
+					t.Errorf("%s = %#v, want %#v", name, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestWriteFiles(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "out")
+
+	if err := writeFiles(dir, map[string][]byte{"sources.tf": []byte("# sources\n")}); err != nil {
+		t.Fatalf("writeFiles() error = %v", err)
+	}
+
+	if b, err := os.ReadFile(filepath.Join(dir, "sources.tf")); err != nil || string(b) != "# sources\n" {
+		t.Fatalf("sources.tf = %q, %v", b, err)
+	}
+
+	err := writeFiles(dir, map[string][]byte{"provider.tf": nil, "sources.tf": nil})
+	if err == nil || !strings.Contains(err.Error(), "refusing to overwrite") {
+		t.Fatalf("writeFiles() error = %v, want refusing to overwrite", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "provider.tf")); err == nil {
+		t.Error("writeFiles() wrote provider.tf before refusing")
+	}
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// identityModel is the resource identity of sources, destinations and
+// workflows.
+type identityModel struct {
+	Id       types.String `tfsdk:"id"`
+	Endpoint types.String `tfsdk:"endpoint"`
+}
+
+// identitySchema returns the resource identity schema of a kind of resource.
+func identitySchema(kind string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The ID of the " + kind,
+			},
+			"endpoint": identityschema.StringAttribute{
+				OptionalForImport: true,
+				Description:       "The API endpoint the " + kind + " belongs to. When importing, the provider endpoint must match it",
+			},
+		},
+	}
+}
+
+// setIdentity sets the resource identity to id and the endpoint of c.
+// Identity is nil when Terraform does not support resource identities.
+func setIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, c client, id types.String) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+
+	return identity.Set(ctx, identityModel{Id: id, Endpoint: clientEndpoint(c)})
+}
+
+// keepIdentity leaves the prior resource identity in place, as Terraform
+// rejects identity changes, such as a change of the provider endpoint or of how
+// it is written. Only resources without an identity, which earlier provider
+// versions wrote, have it set as on create.
+func keepIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, c client, id types.String) diag.Diagnostics {
+	if identity == nil || !identity.Raw.IsFullyNull() {
+		return nil
+	}
+
+	return setIdentity(ctx, identity, c, id)
+}
+
+// importID returns the ID of the resource to import, either the import ID or
+// the id of the import identity. An identity whose endpoint is not the one c
+// calls is an error.
+func importID(ctx context.Context, kind string, c client, req resource.ImportStateRequest, diags *diag.Diagnostics) string {
+	if req.ID != "" || req.Identity == nil {
+		return req.ID
+	}
+
+	var identity identityModel
+	diags.Append(req.Identity.Get(ctx, &identity)...)
+	if diags.HasError() {
+		return ""
+	}
+
+	// The provider endpoint has its trailing slash removed
+	endpoint := clientEndpoint(c)
+	if !identity.Endpoint.IsNull() && !endpoint.IsNull() && strings.TrimSuffix(identity.Endpoint.ValueString(), "/") != endpoint.ValueString() {
+		diags.AddError(
+			"Import endpoint mismatch",
+			fmt.Sprintf("The %s to import belongs to the endpoint %s, but the provider is configured for %s. Import it with a provider configured for %[2]s.", kind, identity.Endpoint.ValueString(), endpoint.ValueString()),
+		)
+		return ""
+	}
+
+	return identity.Id.ValueString()
+}
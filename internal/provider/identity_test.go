@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws-gopher/unstructured-sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const testEndpoint = "https://api.example.com/api/v1"
+
+func TestResourceIdentity(t *testing.T) {
+	fake := newFakeClient()
+	fake.sources["source-1"] = &unstructured.Source{ID: "source-1", Name: "existing", Type: unstructured.ConnectorTypeS3, Config: &unstructured.S3SourceConnectorConfig{RemoteURL: "s3://existing/"}}
+	fake.workflows["workflow-1"] = &unstructured.Workflow{ID: "workflow-1", Name: "existing"}
+
+	c := &endpointClient{client: fake, endpoint: testEndpoint}
+
+	tests := []struct {
+		name  string
+		r     resource.Resource
+		op    string
+		prior func(t *testing.T) any
+		plan  func(t *testing.T) any
+		// identity is the endpoint of the prior identity, if any.
+		identity string
+		want     string
+	}{
+		{
+			name: "source create",
+			r:    NewSourceResource(),
+			op:   "Create",
+			plan: func(t *testing.T) any { return testSourceModel(t, "", "new", "s3://bucket/") },
+			want: "source-2",
+		},
+		{
+			name:  "source read",
+			r:     NewSourceResource(),
+			op:    "Read",
+			prior: func(t *testing.T) any { return testSourceModel(t, "source-1", "existing", "s3://existing/") },
+			want:  "source-1",
+		},
+		{
+			name: "destination create",
+			r:    NewDestinationResource(),
+			op:   "Create",
+			plan: func(t *testing.T) any { return testDestinationModel(t, "", "new", "s3://bucket/") },
+			want: "destination-1",
+		},
+		{
+			name:  "workflow read",
+			r:     NewWorkflowResource(),
+			op:    "Read",
+			prior: func(t *testing.T) any { return testWorkflowModel(t, "workflow-1", "existing", "") },
+			want:  "workflow-1",
+		},
+		{
+			name:     "source read keeps prior identity",
+			r:        NewSourceResource(),
+			op:       "Read",
+			prior:    func(t *testing.T) any { return testSourceModel(t, "source-1", "existing", "s3://existing/") },
+			identity: "https://old.example.com/api/v1",
+			want:     "source-1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			empty := testResource(t, tt.r, c)
+
+			var schemaResp resource.IdentitySchemaResponse
+			tt.r.(resource.ResourceWithIdentity).IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &schemaResp)
+
+			typ := schemaResp.IdentitySchema.Type().TerraformType(ctx)
+			identity := &tfsdk.ResourceIdentity{Schema: schemaResp.IdentitySchema, Raw: tftypes.NewValue(typ, nil)}
+
+			wantEndpoint := testEndpoint
+			if tt.identity != "" {
+				wantEndpoint = tt.identity
+				identity.Raw = tftypes.NewValue(typ, map[string]tftypes.Value{
+					"id":       tftypes.NewValue(tftypes.String, tt.want),
+					"endpoint": tftypes.NewValue(tftypes.String, tt.identity),
+				})
+			}
+
+			switch tt.op {
+			case "Create":
+				plan := testState(t, empty, tt.plan(t))
+				resp := resource.CreateResponse{State: empty, Identity: identity}
+				tt.r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw}}, &resp)
+				if resp.Diagnostics.HasError() {
+					t.Fatalf("Create() diagnostics = %v", resp.Diagnostics)
+				}
+
+			case "Read":
+				prior := testState(t, empty, tt.prior(t))
+				resp := resource.ReadResponse{State: prior, Identity: identity}
+				tt.r.Read(ctx, resource.ReadRequest{State: prior}, &resp)
+				if resp.Diagnostics.HasError() {
+					t.Fatalf("Read() diagnostics = %v", resp.Diagnostics)
+				}
+			}
+
+			var got identityModel
+			if diags := identity.Get(ctx, &got); diags.HasError() {
+				t.Fatalf("Identity.Get() diagnostics = %v", diags)
+			}
+
+			if got.Id.ValueString() != tt.want || got.Endpoint.ValueString() != wantEndpoint {
+				t.Errorf("identity = %s %s, want %s %s", got.Id, got.Endpoint, tt.want, wantEndpoint)
+			}
+		})
+	}
+}
+
+func TestImportStateIdentity(t *testing.T) {
+	fake := newFakeClient()
+	fake.sources["source-1"] = &unstructured.Source{ID: "source-1", Name: "existing", Type: unstructured.ConnectorTypeS3, Config: &unstructured.S3SourceConnectorConfig{RemoteURL: "s3://existing/"}}
+	fake.destinations["destination-1"] = &unstructured.Destination{ID: "destination-1", Name: "existing", Type: unstructured.ConnectorTypeS3, Config: &unstructured.S3DestinationConnectorConfig{RemoteURL: "s3://existing/"}}
+	fake.workflows["workflow-1"] = &unstructured.Workflow{ID: "workflow-1", Name: "existing"}
+
+	c := &endpointClient{client: fake, endpoint: testEndpoint}
+
+	tests := []struct {
+		name     string
+		r        resource.Resource
+		id       string
+		identity map[string]string
+		want     string
+		wantErr  string
+	}{
+		{name: "source by ID", r: NewSourceResource(), id: "source-1", want: "source-1"},
+		{name: "source by identity", r: NewSourceResource(), identity: map[string]string{"id": "source-1"}, want: "source-1"},
+		{name: "destination by ID", r: NewDestinationResource(), id: "destination-1", want: "destination-1"},
+		{name: "destination by identity with endpoint", r: NewDestinationResource(), identity: map[string]string{"id": "destination-1", "endpoint": testEndpoint}, want: "destination-1"},
+		{name: "workflow by identity", r: NewWorkflowResource(), identity: map[string]string{"id": "workflow-1"}, want: "workflow-1"},
+		{name: "by identity with endpoint trailing slash", r: NewSourceResource(), identity: map[string]string{"id": "source-1", "endpoint": testEndpoint + "/"}, want: "source-1"},
+		{name: "endpoint mismatch", r: NewSourceResource(), identity: map[string]string{"id": "source-1", "endpoint": "https://other.example.com/api/v1"}, wantErr: "Import endpoint mismatch"},
+		{name: "missing", r: NewWorkflowResource(), identity: map[string]string{"id": "workflow-2"}, wantErr: "Error importing workflow"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			empty := testResource(t, tt.r, c)
+
+			var schemaResp resource.IdentitySchemaResponse
+			tt.r.(resource.ResourceWithIdentity).IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &schemaResp)
+
+			typ := schemaResp.IdentitySchema.Type().TerraformType(ctx).(tftypes.Object)
+			identity := &tfsdk.ResourceIdentity{Schema: schemaResp.IdentitySchema, Raw: tftypes.NewValue(typ, nil)}
+
+			req := resource.ImportStateRequest{ID: tt.id}
+			if tt.identity != nil {
+				attrs := map[string]tftypes.Value{
+					"id":       tftypes.NewValue(tftypes.String, nil),
+					"endpoint": tftypes.NewValue(tftypes.String, nil),
+				}
+				for name, v := range tt.identity {
+					attrs[name] = tftypes.NewValue(tftypes.String, v)
+				}
+
+				identity.Raw = tftypes.NewValue(typ, attrs)
+				req.Identity = identity
+			}
+
+			resp := resource.ImportStateResponse{State: empty, Identity: identity}
+			tt.r.(resource.ResourceWithImportState).ImportState(ctx, req, &resp)
+
+			if tt.wantErr != "" {
+				if !strings.Contains(fmt.Sprint(resp.Diagnostics), tt.wantErr) {
+					t.Fatalf("ImportState() diagnostics = %v, want %q", resp.Diagnostics, tt.wantErr)
+				}
+				return
+			}
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("ImportState() diagnostics = %v", resp.Diagnostics)
+			}
+
+			var id types.String
+			if diags := resp.State.GetAttribute(ctx, path.Root("id"), &id); diags.HasError() {
+				t.Fatalf("State.GetAttribute() diagnostics = %v", diags)
+			}
+
+			var got identityModel
+			if diags := resp.Identity.Get(ctx, &got); diags.HasError() {
+				t.Fatalf("Identity.Get() diagnostics = %v", diags)
+			}
+
+			if id.ValueString() != tt.want || got.Id.ValueString() != tt.want || got.Endpoint.ValueString() != testEndpoint {
+				t.Errorf("state id = %s, identity = %s %s, want %s %s", id, got.Id, got.Endpoint, tt.want, testEndpoint)
+			}
+		})
+	}
+}
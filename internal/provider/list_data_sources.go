@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/convert"
+	"github.com/aws-gopher/unstructured-sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// workflowPageSize is the number of workflows requested per page when
+// listing workflows.
+var workflowPageSize = 100
+
+// listElementAttributes returns the attributes of a single-object data
+// source schema with its lookup attributes made computed, to describe the
+// elements of the matching list data source. Their descriptions are dropped,
+// as they describe the lookup.
+func listElementAttributes(s schema.Schema) map[string]schema.Attribute {
+	attrs := make(map[string]schema.Attribute, len(s.Attributes))
+	for name, a := range s.Attributes {
+		if a, ok := a.(schema.StringAttribute); ok && (a.Required || a.Optional) {
+			a.Required = false
+			a.Optional = false
+			a.Computed = true
+			a.Validators = nil
+			a.Description = ""
+			a.MarkdownDescription = ""
+			attrs[name] = a
+			continue
+		}
+
+		attrs[name] = a
+	}
+
+	return attrs
+}
+
+// connectorTypeFilters returns the values a type filter accepts: the
+// connector blocks, and the connector types of those whose type the API names
+// differently, as typeOf returns them.
+func connectorTypeFilters(blocks []string, typeOf func(block string) string) []string {
+	filters := slices.Clone(blocks)
+	for _, block := range blocks {
+		if typ := typeOf(block); typ != "" && typ != block {
+			filters = append(filters, typ)
+		}
+	}
+
+	return filters
+}
+
+// nameRegex compiles the name_regex attribute of a list data source. It
+// returns nil if the attribute is null.
+func nameRegex(v types.String, diags *diag.Diagnostics) *regexp.Regexp {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+
+	re, err := regexp.Compile(v.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("name_regex"), "Invalid name_regex", err.Error())
+		return nil
+	}
+
+	return re
+}
+
+// matchName reports whether name matches re, which may be nil to match any
+// name.
+func matchName(re *regexp.Regexp, name string) bool {
+	return re == nil || re.MatchString(name)
+}
+
+// emptyIfNull returns an empty list in place of a null one. Projected models
+// have null lists where the API returned none, but a list data source without
+// matches should return an empty list.
+func emptyIfNull(ctx context.Context, l types.List) types.List {
+	if !l.IsNull() {
+		return l
+	}
+
+	return types.ListValueMust(l.ElementType(ctx), []attr.Value{})
+}
+
+// listAllWorkflows lists the workflows matching in, requesting one page at a
+// time until a page is not full.
+func listAllWorkflows(ctx context.Context, c client, in unstructured.ListWorkflowsRequest) ([]unstructured.Workflow, error) {
+	pageSize := workflowPageSize
+	in.PageSize = &pageSize
+
+	var all []unstructured.Workflow
+	seen := map[string]bool{}
+
+	for page := 1; ; page++ {
+		in.Page = &page
+
+		workflows, err := c.ListWorkflows(ctx, &in)
+		if err != nil {
+			return nil, err
+		}
+
+		added := 0
+		for _, workflow := range workflows {
+			if !seen[workflow.ID] {
+				seen[workflow.ID] = true
+				all = append(all, workflow)
+				added++
+			}
+		}
+
+		// Stop on a short page, or if the API ignores the page and returns
+		// the same workflows again.
+		if len(workflows) < pageSize || added == 0 {
+			return all, nil
+		}
+	}
+}
+
+// listSources lists the sources whose name matches re and, if typ is set,
+// that have the connector type or connector block typ, which the API filters
+// by. Sources of unsupported types are listed too, and also returned as
+// "<id> (<type>)".
+func listSources(ctx context.Context, c client, typ string, re *regexp.Regexp) ([]unstructured.Source, []string, error) {
+	if t := convert.SourceConnectorType(typ); t != "" {
+		typ = t
+	}
+
+	sources, err := c.ListSources(ctx, typ)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var matches []unstructured.Source
+	var unsupported []string
+
+	for i := range sources {
+		if !matchName(re, sources[i].Name) {
+			continue
+		}
+
+		if _, ok := convert.Source(&sources[i]); !ok {
+			unsupported = append(unsupported, fmt.Sprintf("%s (%s)", sources[i].ID, sources[i].Type))
+		}
+
+		matches = append(matches, sources[i])
+	}
+
+	return matches, unsupported, nil
+}
+
+// listDestinations lists the destinations whose name matches re and, if typ
+// is set, that have the connector type or connector block typ, which the API
+// filters by. Destinations of unsupported types are listed too, and also
+// returned as "<id> (<type>)".
+func listDestinations(ctx context.Context, c client, typ string, re *regexp.Regexp) ([]unstructured.Destination, []string, error) {
+	if t := convert.DestinationConnectorType(typ); t != "" {
+		typ = t
+	}
+
+	destinations, err := c.ListDestinations(ctx, typ)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var matches []unstructured.Destination
+	var unsupported []string
+
+	for i := range destinations {
+		if !matchName(re, destinations[i].Name) {
+			continue
+		}
+
+		if _, ok := convert.Destination(&destinations[i]); !ok {
+			unsupported = append(unsupported, fmt.Sprintf("%s (%s)", destinations[i].ID, destinations[i].Type))
+		}
+
+		matches = append(matches, destinations[i])
+	}
+
+	return matches, unsupported, nil
+}
@@ -0,0 +1,250 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws-gopher/unstructured-sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+// testListNames returns the name attribute of each element of a list data
+// source attribute.
+func testListNames(t *testing.T, list types.List) []string {
+	t.Helper()
+
+	if list.IsNull() || list.IsUnknown() {
+		t.Fatalf("list = %s, want a known list", list)
+	}
+
+	names := make([]string, 0, len(list.Elements()))
+	for _, e := range list.Elements() {
+		obj, ok := e.(types.Object)
+		if !ok {
+			t.Fatalf("list element = %T, want types.Object", e)
+		}
+
+		name, _ := obj.Attributes()["name"].(types.String)
+		names = append(names, name.ValueString())
+	}
+
+	return names
+}
+
+// testListRead reads a list data source with the given string attributes
+// configured, and returns the names in its list attribute.
+func testListRead(t *testing.T, d datasource.DataSource, c client, config map[string]string, model any, get func() types.List) ([]string, string, string) {
+	t.Helper()
+
+	attrs := make(map[string]tftypes.Value, len(config))
+	for name, v := range config {
+		attrs[name] = tftypes.NewValue(tftypes.String, v)
+	}
+
+	state, diags := testDataSourceReadConfig(t, d, c, attrs)
+	if diags.HasError() {
+		return nil, fmt.Sprint(diags.Errors()), ""
+	}
+
+	if d := state.Get(t.Context(), model); d.HasError() {
+		t.Fatalf("State.Get() diagnostics = %v", d)
+	}
+
+	return testListNames(t, get()), "", fmt.Sprint(diags.Warnings())
+}
+
+func TestSourcesDataSource(t *testing.T) {
+	c := newFakeClient()
+	c.sources["source-1"] = &unstructured.Source{ID: "source-1", Name: "prod-s3", Type: unstructured.ConnectorTypeS3, Config: &unstructured.S3SourceConnectorConfig{RemoteURL: "s3://prod/"}}
+	c.sources["source-2"] = &unstructured.Source{ID: "source-2", Name: "prod-gcs", Type: unstructured.ConnectorTypeGCS, Config: &unstructured.GCSSourceConnectorConfig{RemoteURL: "gs://prod/"}}
+	c.sources["source-3"] = &unstructured.Source{ID: "source-3", Name: "dev-s3", Type: unstructured.ConnectorTypeS3, Config: &unstructured.S3SourceConnectorConfig{RemoteURL: "s3://dev/"}}
+	c.sources["source-4"] = &unstructured.Source{ID: "source-4", Name: "team-slack", Type: unstructured.ConnectorTypeSlack, Config: &unstructured.SlackSourceConnectorConfig{}}
+
+	tests := []struct {
+		name        string
+		config      map[string]string
+		want        []string
+		wantErr     string
+		wantWarning string
+	}{
+		{name: "all", want: []string{"prod-s3", "prod-gcs", "dev-s3", "team-slack"}, wantWarning: "source-4 (slack)"},
+		{name: "type", config: map[string]string{"type": "s3"}, want: []string{"prod-s3", "dev-s3"}},
+		{name: "name regex", config: map[string]string{"name_regex": "^prod-"}, want: []string{"prod-s3", "prod-gcs"}},
+		{name: "type and name regex", config: map[string]string{"type": "s3", "name_regex": "^prod-"}, want: []string{"prod-s3"}},
+		{name: "no matches", config: map[string]string{"name_regex": "^staging-"}, want: []string{}},
+		{name: "invalid name regex", config: map[string]string{"name_regex": "("}, wantErr: "Invalid name_regex"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var model sourcesDataSourceModel
+			got, errs, warnings := testListRead(t, NewSourcesDataSource(), c, tt.config, &model, func() types.List { return model.Sources })
+
+			if !strings.Contains(errs, tt.wantErr) || (tt.wantErr == "" && errs != "") {
+				t.Fatalf("Read() errors = %s, want %q", errs, tt.wantErr)
+			}
+
+			if tt.wantErr != "" {
+				return
+			}
+
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("sources = %v, want %v", got, tt.want)
+			}
+
+			if !strings.Contains(warnings, tt.wantWarning) || (tt.wantWarning == "" && warnings != "[]") {
+				t.Errorf("Read() warnings = %s, want %q", warnings, tt.wantWarning)
+			}
+		})
+	}
+
+	t.Run("unsupported type config", func(t *testing.T) {
+		var model sourcesDataSourceModel
+		testListRead(t, NewSourcesDataSource(), c, map[string]string{"name_regex": "slack"}, &model, func() types.List { return model.Sources })
+
+		obj, _ := model.Sources.Elements()[0].(types.Object)
+		if config, _ := obj.Attributes()["config_json"].(types.String); !strings.Contains(config.ValueString(), `"channels"`) {
+			t.Errorf("config_json = %s, want the slack config", config)
+		}
+	})
+}
+
+func TestDestinationsDataSource(t *testing.T) {
+	c := newFakeClient()
+	c.destinations["destination-1"] = &unstructured.Destination{ID: "destination-1", Name: "prod-s3", Type: unstructured.ConnectorTypeS3, Config: &unstructured.S3DestinationConnectorConfig{RemoteURL: "s3://prod/"}}
+	c.destinations["destination-2"] = &unstructured.Destination{ID: "destination-2", Name: "prod-pinecone", Type: unstructured.ConnectorTypePinecone, Config: &unstructured.PineconeDestinationConnectorConfig{IndexName: "prod"}}
+	c.destinations["destination-3"] = &unstructured.Destination{ID: "destination-3", Name: "events", Type: unstructured.ConnectorTypeKafkaCloud, Config: &unstructured.KafkaCloudDestinationConnectorConfig{Topic: "events"}}
+
+	tests := []struct {
+		name   string
+		config map[string]string
+		want   []string
+	}{
+		{name: "all", want: []string{"prod-s3", "prod-pinecone", "events"}},
+		{name: "type", config: map[string]string{"type": "pinecone"}, want: []string{"prod-pinecone"}},
+		{name: "connector type", config: map[string]string{"type": "kafka-cloud"}, want: []string{"events"}},
+		{name: "connector block", config: map[string]string{"type": "kafka_cloud"}, want: []string{"events"}},
+		{name: "name regex", config: map[string]string{"name_regex": "s3$"}, want: []string{"prod-s3"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var model destinationsDataSourceModel
+			got, errs, _ := testListRead(t, NewDestinationsDataSource(), c, tt.config, &model, func() types.List { return model.Destinations })
+
+			if errs != "" {
+				t.Fatalf("Read() errors = %s", errs)
+			}
+
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("destinations = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWorkflowsDataSource(t *testing.T) {
+	pageSize := workflowPageSize
+	workflowPageSize = 2
+	t.Cleanup(func() { workflowPageSize = pageSize })
+
+	c := newFakeClient()
+	for i, status := range []unstructured.WorkflowState{"active", "inactive", "active", "active", "inactive"} {
+		id := fmt.Sprintf("workflow-%d", i+1)
+		c.workflows[id] = &unstructured.Workflow{
+			ID:           id,
+			Name:         fmt.Sprintf("%s-%d", status, i+1),
+			Sources:      []string{fmt.Sprintf("source-%d", i%2+1)},
+			Destinations: []string{"destination-1"},
+			Status:       status,
+		}
+	}
+
+	tests := []struct {
+		name      string
+		config    map[string]string
+		want      []string
+		wantCalls int
+	}{
+		{name: "all pages", want: []string{"active-1", "inactive-2", "active-3", "active-4", "inactive-5"}, wantCalls: 3},
+		{name: "status", config: map[string]string{"status": "active"}, want: []string{"active-1", "active-3", "active-4"}, wantCalls: 2},
+		{name: "source", config: map[string]string{"source_id": "source-2"}, want: []string{"inactive-2", "active-4"}, wantCalls: 2},
+		{name: "destination and name regex", config: map[string]string{"destination_id": "destination-1", "name_regex": "-[45]$"}, want: []string{"active-4", "inactive-5"}, wantCalls: 3},
+		{name: "no matches", config: map[string]string{"destination_id": "destination-2"}, want: []string{}, wantCalls: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c.listWorkflowsCalls = 0
+
+			var model workflowsDataSourceModel
+			got, errs, _ := testListRead(t, NewWorkflowsDataSource(), c, tt.config, &model, func() types.List { return model.Workflows })
+
+			if errs != "" {
+				t.Fatalf("Read() errors = %s", errs)
+			}
+
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("workflows = %v, want %v", got, tt.want)
+			}
+
+			if c.listWorkflowsCalls != tt.wantCalls {
+				t.Errorf("ListWorkflows() calls = %d, want %d", c.listWorkflowsCalls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestAccSourcesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourcesDataSourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.unstructured_sources.test",
+						tfjsonpath.New("sources"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.ObjectPartial(map[string]knownvalue.Check{
+								"name": knownvalue.StringExact("Terraform Test Sources Data Source"),
+								"s3": knownvalue.ObjectPartial(map[string]knownvalue.Check{
+									"remote_url": knownvalue.StringExact("s3://example-bucket/"),
+								}),
+							}),
+						}),
+					),
+				},
+			},
+		},
+	})
+}
+
+const testAccSourcesDataSourceConfig = `
+resource "unstructured_source" "test" {
+  name = "Terraform Test Sources Data Source"
+
+  s3 = {
+    remote_url = "s3://example-bucket/"
+    anonymous  = true
+  }
+}
+
+data "unstructured_sources" "test" {
+  type       = "s3"
+  name_regex = "^Terraform Test Sources Data Source$"
+
+  depends_on = [unstructured_source.test]
+}
+`
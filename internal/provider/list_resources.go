@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// configureListResource returns the client of a list resource from the
+// provider data, or nil before the provider is configured.
+func configureListResource(req resource.ConfigureRequest, resp *resource.ConfigureResponse) client {
+	if req.ProviderData == nil {
+		return nil
+	}
+
+	c, ok := req.ProviderData.(client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected an Unstructured API client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return nil
+	}
+
+	return c
+}
+
+// listResults streams a result for each of items, up to the request limit.
+// The warnings, if any, are streamed first. name returns the ID and name of
+// an item, and toModel its resource model, which is only converted when
+// Terraform asks for the resource.
+func listResults[T any, M any](ctx context.Context, c client, req list.ListRequest, warnings diag.Diagnostics, items []T, name func(*T) (string, string), toModel func(context.Context, *T) (M, diag.Diagnostics)) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		if len(warnings) > 0 && !push(list.ListResult{Diagnostics: warnings}) {
+			return
+		}
+
+		for i := range items {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+
+			id, displayName := name(&items[i])
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = displayName
+			result.Diagnostics.Append(setIdentity(ctx, result.Identity, c, types.StringValue(id))...)
+
+			if req.IncludeResource && !result.Diagnostics.HasError() {
+				model, diags := toModel(ctx, &items[i])
+				result.Diagnostics.Append(diags...)
+				if !diags.HasError() {
+					result.Diagnostics.Append(result.Resource.Set(ctx, model)...)
+				}
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
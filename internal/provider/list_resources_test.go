@@ -0,0 +1,223 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws-gopher/unstructured-sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testListRequest describes a call to the List method of a list resource.
+type testListRequest struct {
+	config          map[string]string
+	includeResource bool
+	limit           int64
+}
+
+// testListResults configures l with c, lists it with the given string
+// attributes configured, and returns the "<id> <name>" of each result. When
+// resources are included, the name is read from the resource instead of the
+// display name.
+func testListResults(t *testing.T, l list.ListResource, r resource.ResourceWithIdentity, c client, in testListRequest) ([]string, string, string) {
+	t.Helper()
+
+	ctx := t.Context()
+
+	if l, ok := l.(list.ListResourceWithConfigure); ok {
+		var resp resource.ConfigureResponse
+		l.Configure(ctx, resource.ConfigureRequest{ProviderData: c}, &resp)
+
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("Configure() diagnostics = %v", resp.Diagnostics)
+		}
+	}
+
+	var schemaResp list.ListResourceSchemaResponse
+	l.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &schemaResp)
+
+	var resourceResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resourceResp)
+
+	var identityResp resource.IdentitySchemaResponse
+	r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identityResp)
+
+	typ := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	attrs := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+	for name, attrType := range typ.AttributeTypes {
+		attrs[name] = tftypes.NewValue(attrType, nil)
+	}
+	for name, v := range in.config {
+		attrs[name] = tftypes.NewValue(tftypes.String, v)
+	}
+
+	var stream list.ListResultsStream
+	l.List(ctx, list.ListRequest{
+		Config:                 tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(typ, attrs)},
+		IncludeResource:        in.includeResource,
+		Limit:                  in.limit,
+		ResourceSchema:         resourceResp.Schema,
+		ResourceIdentitySchema: identityResp.IdentitySchema,
+	}, &stream)
+
+	var got []string
+	var errs, warnings []string
+
+	for result := range stream.Results {
+		for _, d := range result.Diagnostics.Errors() {
+			errs = append(errs, d.Summary()+": "+d.Detail())
+		}
+		for _, d := range result.Diagnostics.Warnings() {
+			warnings = append(warnings, d.Summary()+": "+d.Detail())
+		}
+
+		if result.Identity == nil {
+			continue
+		}
+
+		var identity identityModel
+		if diags := result.Identity.Get(ctx, &identity); diags.HasError() {
+			t.Fatalf("Identity.Get() diagnostics = %v", diags)
+		}
+
+		name := result.DisplayName
+		if in.includeResource {
+			var v types.String
+			if diags := result.Resource.GetAttribute(ctx, path.Root("name"), &v); diags.HasError() {
+				t.Fatalf("Resource.GetAttribute() diagnostics = %v", diags)
+			}
+			name = v.ValueString()
+		} else if !result.Resource.Raw.IsNull() {
+			t.Errorf("result %s has a resource, want none", identity.Id)
+		}
+
+		got = append(got, identity.Id.ValueString()+" "+name)
+	}
+
+	return got, strings.Join(errs, "; "), strings.Join(warnings, "; ")
+}
+
+func TestSourceListResource(t *testing.T) {
+	c := newFakeClient()
+	c.sources["source-1"] = &unstructured.Source{ID: "source-1", Name: "prod-s3", Type: unstructured.ConnectorTypeS3, Config: &unstructured.S3SourceConnectorConfig{RemoteURL: "s3://prod/"}}
+	c.sources["source-2"] = &unstructured.Source{ID: "source-2", Name: "prod-gcs", Type: unstructured.ConnectorTypeGCS, Config: &unstructured.GCSSourceConnectorConfig{RemoteURL: "gs://prod/"}}
+	c.sources["source-3"] = &unstructured.Source{ID: "source-3", Name: "dev-s3", Type: unstructured.ConnectorTypeS3, Config: &unstructured.S3SourceConnectorConfig{RemoteURL: "s3://dev/"}}
+	c.sources["source-4"] = &unstructured.Source{ID: "source-4", Name: "team-slack", Type: unstructured.ConnectorTypeSlack, Config: &unstructured.SlackSourceConnectorConfig{}}
+
+	tests := []struct {
+		name        string
+		in          testListRequest
+		want        []string
+		wantErr     string
+		wantWarning string
+	}{
+		{name: "all", want: []string{"source-1 prod-s3", "source-2 prod-gcs", "source-3 dev-s3", "source-4 team-slack"}, wantWarning: "source-4 (slack)"},
+		{name: "unsupported type", in: testListRequest{config: map[string]string{"name_regex": "slack"}, includeResource: true}, want: []string{"source-4 team-slack"}, wantWarning: "source-4 (slack)"},
+		{name: "type", in: testListRequest{config: map[string]string{"type": "s3"}}, want: []string{"source-1 prod-s3", "source-3 dev-s3"}},
+		{name: "name regex", in: testListRequest{config: map[string]string{"name_regex": "^prod-"}}, want: []string{"source-1 prod-s3", "source-2 prod-gcs"}},
+		{name: "include resource", in: testListRequest{config: map[string]string{"type": "gcs"}, includeResource: true}, want: []string{"source-2 prod-gcs"}},
+		{name: "limit", in: testListRequest{config: map[string]string{"type": "s3"}, limit: 1}, want: []string{"source-1 prod-s3"}},
+		{name: "invalid name regex", in: testListRequest{config: map[string]string{"name_regex": "("}}, wantErr: "Invalid name_regex"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, errs, warnings := testListResults(t, NewSourceListResource(), NewSourceResource().(resource.ResourceWithIdentity), c, tt.in)
+
+			if !strings.Contains(errs, tt.wantErr) || (tt.wantErr == "" && errs != "") {
+				t.Fatalf("List() errors = %s, want %q", errs, tt.wantErr)
+			}
+
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("List() = %v, want %v", got, tt.want)
+			}
+
+			if !strings.Contains(warnings, tt.wantWarning) || (tt.wantWarning == "" && warnings != "") {
+				t.Errorf("List() warnings = %s, want %q", warnings, tt.wantWarning)
+			}
+		})
+	}
+}
+
+func TestDestinationListResource(t *testing.T) {
+	c := newFakeClient()
+	c.destinations["destination-1"] = &unstructured.Destination{ID: "destination-1", Name: "prod-s3", Type: unstructured.ConnectorTypeS3, Config: &unstructured.S3DestinationConnectorConfig{RemoteURL: "s3://prod/"}}
+	c.destinations["destination-2"] = &unstructured.Destination{ID: "destination-2", Name: "prod-pinecone", Type: unstructured.ConnectorTypePinecone, Config: &unstructured.PineconeDestinationConnectorConfig{IndexName: "prod"}}
+
+	tests := []struct {
+		name string
+		in   testListRequest
+		want []string
+	}{
+		{name: "all", want: []string{"destination-1 prod-s3", "destination-2 prod-pinecone"}},
+		{name: "type", in: testListRequest{config: map[string]string{"type": "pinecone"}}, want: []string{"destination-2 prod-pinecone"}},
+		{name: "include resource", in: testListRequest{config: map[string]string{"name_regex": "s3$"}, includeResource: true}, want: []string{"destination-1 prod-s3"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, errs, _ := testListResults(t, NewDestinationListResource(), NewDestinationResource().(resource.ResourceWithIdentity), c, tt.in)
+
+			if errs != "" {
+				t.Fatalf("List() errors = %s", errs)
+			}
+
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("List() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWorkflowListResource(t *testing.T) {
+	pageSize := workflowPageSize
+	workflowPageSize = 2
+	t.Cleanup(func() { workflowPageSize = pageSize })
+
+	active, inactive := unstructured.WorkflowStateActive, unstructured.WorkflowStateInactive
+
+	c := newFakeClient()
+	c.workflows["workflow-1"] = &unstructured.Workflow{ID: "workflow-1", Name: "prod-ingest", Sources: []string{"source-1"}, Status: active}
+	c.workflows["workflow-2"] = &unstructured.Workflow{ID: "workflow-2", Name: "prod-reindex", Sources: []string{"source-2"}, Status: inactive}
+	c.workflows["workflow-3"] = &unstructured.Workflow{ID: "workflow-3", Name: "dev-ingest", Sources: []string{"source-1"}, Status: active}
+
+	tests := []struct {
+		name    string
+		in      testListRequest
+		want    []string
+		err     error
+		wantErr string
+	}{
+		{name: "all", want: []string{"workflow-1 prod-ingest", "workflow-2 prod-reindex", "workflow-3 dev-ingest"}},
+		{name: "status", in: testListRequest{config: map[string]string{"status": "inactive"}}, want: []string{"workflow-2 prod-reindex"}},
+		{name: "source and name regex", in: testListRequest{config: map[string]string{"source_id": "source-1", "name_regex": "^dev-"}}, want: []string{"workflow-3 dev-ingest"}},
+		{name: "include resource", in: testListRequest{config: map[string]string{"name_regex": "ingest$"}, includeResource: true}, want: []string{"workflow-1 prod-ingest", "workflow-3 dev-ingest"}},
+		{name: "API error", err: errors.New("boom"), wantErr: "Error listing workflows"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c.err = tt.err
+
+			got, errs, _ := testListResults(t, NewWorkflowListResource(), NewWorkflowResource().(resource.ResourceWithIdentity), c, tt.in)
+
+			if !strings.Contains(errs, tt.wantErr) || (tt.wantErr == "" && errs != "") {
+				t.Fatalf("List() errors = %s, want %q", errs, tt.wantErr)
+			}
+
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("List() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// idOrName returns a validator requiring a data source to be looked up by
+// exactly one of id and name.
+func idOrName() datasource.ConfigValidator {
+	return datasourcevalidator.ExactlyOneOf(path.MatchRoot("id"), path.MatchRoot("name"))
+}
+
+// findByName returns the only one of objects with the given name, where
+// fields returns the ID and name of an object. It reports an error if no
+// object or more than one object has the name.
+func findByName[T any](kind, name string, objects []T, fields func(*T) (string, string), diags *diag.Diagnostics) *T {
+	var matches []*T
+	var ids []string
+
+	for i := range objects {
+		if id, n := fields(&objects[i]); n == name {
+			matches = append(matches, &objects[i])
+			ids = append(ids, id)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		diags.AddAttributeError(
+			path.Root("name"),
+			fmt.Sprintf("No %s found", kind),
+			fmt.Sprintf("No %s is named %q.", kind, name),
+		)
+		return nil
+	case 1:
+		return matches[0]
+	}
+
+	diags.AddAttributeError(
+		path.Root("name"),
+		fmt.Sprintf("Multiple %ss found", kind),
+		fmt.Sprintf("%d %ss are named %q: %s. Look the %s up by id instead.", len(matches), kind, name, strings.Join(ids, ", "), kind),
+	)
+
+	return nil
+}
+
+// checkConnectorType reports an error if want is set and is not got, the
+// connector type of the object with the given ID.
+func checkConnectorType(kind, id, got, want string, diags *diag.Diagnostics) {
+	if want == "" || want == got {
+		return
+	}
+
+	diags.AddAttributeError(
+		path.Root("type"),
+		fmt.Sprintf("Unexpected %s type", kind),
+		fmt.Sprintf("The %s %s has connector type %q, not %q.", kind, id, got, want),
+	)
+}
@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws-gopher/unstructured-sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDataSourceLookupByName(t *testing.T) {
+	c := newFakeClient()
+	c.sources["source-1"] = &unstructured.Source{ID: "source-1", Name: "prod-sharepoint-hr", Type: unstructured.ConnectorTypeS3, Config: &unstructured.S3SourceConnectorConfig{RemoteURL: "s3://hr/"}}
+	c.sources["source-2"] = &unstructured.Source{ID: "source-2", Name: "shared", Type: unstructured.ConnectorTypeS3, Config: &unstructured.S3SourceConnectorConfig{RemoteURL: "s3://a/"}}
+	c.sources["source-3"] = &unstructured.Source{ID: "source-3", Name: "shared", Type: unstructured.ConnectorTypeS3, Config: &unstructured.S3SourceConnectorConfig{RemoteURL: "s3://b/"}}
+	c.sources["source-4"] = &unstructured.Source{ID: "source-4", Name: "shared", Type: unstructured.ConnectorTypeGCS, Config: &unstructured.GCSSourceConnectorConfig{RemoteURL: "gs://c/"}}
+	c.sources["source-5"] = &unstructured.Source{ID: "source-5", Name: "events", Type: unstructured.ConnectorTypeKafkaCloud, Config: &unstructured.KafkaCloudSourceConnectorConfig{Topic: "events"}}
+	c.destinations["destination-1"] = &unstructured.Destination{ID: "destination-1", Name: "prod-output", Type: unstructured.ConnectorTypeS3, Config: &unstructured.S3DestinationConnectorConfig{RemoteURL: "s3://out/"}}
+	c.destinations["destination-2"] = &unstructured.Destination{ID: "destination-2", Name: "events", Type: unstructured.ConnectorTypeKafkaCloud, Config: &unstructured.KafkaCloudDestinationConnectorConfig{Topic: "events"}}
+	c.workflows["workflow-1"] = &unstructured.Workflow{ID: "workflow-1", Name: "nightly", Status: unstructured.WorkflowStateActive}
+	c.workflows["workflow-2"] = &unstructured.Workflow{ID: "workflow-2", Name: "nightly-backfill", Status: unstructured.WorkflowStateActive}
+
+	tests := []struct {
+		name       string
+		dataSource func() datasource.DataSource
+		config     map[string]string
+		wantID     string
+		wantType   string
+		wantErr    string
+	}{
+		{name: "source by name", dataSource: NewSourceDataSource, config: map[string]string{"name": "prod-sharepoint-hr"}, wantID: "source-1", wantType: "s3"},
+		{name: "source by id", dataSource: NewSourceDataSource, config: map[string]string{"id": "source-2"}, wantID: "source-2"},
+		{name: "source name not found", dataSource: NewSourceDataSource, config: map[string]string{"name": "prod-sharepoint"}, wantErr: `No source is named "prod-sharepoint"`},
+		{name: "source name ambiguous", dataSource: NewSourceDataSource, config: map[string]string{"name": "shared"}, wantErr: `3 sources are named "shared": source-2, source-3, source-4`},
+		{name: "source by name and type", dataSource: NewSourceDataSource, config: map[string]string{"name": "shared", "type": "gcs"}, wantID: "source-4", wantType: "gcs"},
+		{name: "source name ambiguous for type", dataSource: NewSourceDataSource, config: map[string]string{"name": "shared", "type": "s3"}, wantErr: `2 s3 sources are named "shared": source-2, source-3`},
+		{name: "source name not found for type", dataSource: NewSourceDataSource, config: map[string]string{"name": "prod-sharepoint-hr", "type": "gcs"}, wantErr: `No gcs source is named "prod-sharepoint-hr"`},
+		{name: "source by id and type", dataSource: NewSourceDataSource, config: map[string]string{"id": "source-4", "type": "gcs"}, wantID: "source-4"},
+		{name: "source by id of another type", dataSource: NewSourceDataSource, config: map[string]string{"id": "source-2", "type": "gcs"}, wantErr: `has connector type "s3", not "gcs"`},
+		{name: "source by name and connector block", dataSource: NewSourceDataSource, config: map[string]string{"name": "events", "type": "kafka_cloud"}, wantID: "source-5", wantType: "kafka-cloud"},
+		{name: "source by id and connector block", dataSource: NewSourceDataSource, config: map[string]string{"id": "source-5", "type": "kafka_cloud"}, wantID: "source-5"},
+		{name: "source by id of another connector block", dataSource: NewSourceDataSource, config: map[string]string{"id": "source-2", "type": "kafka_cloud"}, wantErr: `has connector type "s3", not "kafka-cloud"`},
+		{name: "destination by name", dataSource: NewDestinationDataSource, config: map[string]string{"name": "prod-output"}, wantID: "destination-1", wantType: "s3"},
+		{name: "destination name not found", dataSource: NewDestinationDataSource, config: map[string]string{"name": "missing"}, wantErr: "No destination found"},
+		{name: "destination name not found for type", dataSource: NewDestinationDataSource, config: map[string]string{"name": "prod-output", "type": "pinecone"}, wantErr: "No pinecone destination found"},
+		{name: "destination by name and connector block", dataSource: NewDestinationDataSource, config: map[string]string{"name": "events", "type": "kafka_cloud"}, wantID: "destination-2", wantType: "kafka-cloud"},
+		{name: "workflow by exact name", dataSource: NewWorkflowDataSource, config: map[string]string{"name": "nightly"}, wantID: "workflow-1"},
+		{name: "workflow name not found", dataSource: NewWorkflowDataSource, config: map[string]string{"name": "night"}, wantErr: "No workflow found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attrs := make(map[string]tftypes.Value, len(tt.config))
+			for name, v := range tt.config {
+				attrs[name] = tftypes.NewValue(tftypes.String, v)
+			}
+
+			state, diags := testDataSourceReadConfig(t, tt.dataSource(), c, attrs)
+
+			if tt.wantErr != "" {
+				if !diags.HasError() || !strings.Contains(fmt.Sprint(diags), tt.wantErr) {
+					t.Fatalf("Read() diagnostics = %v, want %q", diags, tt.wantErr)
+				}
+				return
+			}
+
+			if diags.HasError() {
+				t.Fatalf("Read() diagnostics = %v", diags)
+			}
+
+			var id types.String
+			if diags := state.GetAttribute(t.Context(), path.Root("id"), &id); diags.HasError() {
+				t.Fatalf("GetAttribute() diagnostics = %v", diags)
+			}
+
+			if id.ValueString() != tt.wantID {
+				t.Errorf("id = %s, want %s", id, tt.wantID)
+			}
+
+			if tt.wantType == "" {
+				return
+			}
+
+			var typ types.String
+			if diags := state.GetAttribute(t.Context(), path.Root("type"), &typ); diags.HasError() {
+				t.Fatalf("GetAttribute() diagnostics = %v", diags)
+			}
+
+			if typ.ValueString() != tt.wantType {
+				t.Errorf("type = %s, want %s", typ, tt.wantType)
+			}
+		})
+	}
+}
+
+func TestDataSourceIDOrName(t *testing.T) {
+	for _, d := range []datasource.DataSourceWithConfigValidators{&sourceDataSource{}, &destinationDataSource{}, &workflowDataSource{}} {
+		for _, tt := range []struct {
+			config  map[string]string
+			wantErr string
+		}{
+			{config: map[string]string{"id": "id-1"}},
+			{config: map[string]string{"name": "name-1"}},
+			{config: map[string]string{}, wantErr: "Missing Attribute Configuration"},
+			{config: map[string]string{"id": "id-1", "name": "name-1"}, wantErr: "Invalid Attribute Combination"},
+		} {
+			t.Run(fmt.Sprintf("%T %v", d, tt.config), func(t *testing.T) {
+				var schemaResp datasource.SchemaResponse
+				d.Schema(t.Context(), datasource.SchemaRequest{}, &schemaResp)
+
+				typ, _ := schemaResp.Schema.Type().TerraformType(t.Context()).(tftypes.Object)
+
+				attrs := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+				for name, attrType := range typ.AttributeTypes {
+					attrs[name] = tftypes.NewValue(attrType, nil)
+				}
+				for name, v := range tt.config {
+					attrs[name] = tftypes.NewValue(tftypes.String, v)
+				}
+
+				req := datasource.ValidateConfigRequest{
+					Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(typ, attrs)},
+				}
+
+				var diags []string
+				for _, v := range d.ConfigValidators(t.Context()) {
+					var resp datasource.ValidateConfigResponse
+					v.ValidateDataSource(t.Context(), req, &resp)
+
+					for _, d := range resp.Diagnostics.Errors() {
+						diags = append(diags, d.Summary())
+					}
+				}
+
+				got := strings.Join(diags, "\n")
+				if (tt.wantErr == "" && got != "") || !strings.Contains(got, tt.wantErr) {
+					t.Errorf("ConfigValidators() errors = %q, want %q", got, tt.wantErr)
+				}
+			})
+		}
+	}
+}
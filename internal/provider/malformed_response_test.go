@@ -0,0 +1,215 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/aws-gopher/unstructured-sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TestMalformedAPIResponses checks that API objects the converters cannot
+// handle fail reads with a diagnostic instead of being silently dropped.
+func TestMalformedAPIResponses(t *testing.T) {
+	tests := []struct {
+		name       string
+		seed       func(t *testing.T, c *fakeClient)
+		resource   resource.Resource
+		prior      func(t *testing.T) any
+		dataSource datasource.DataSource
+		id         string
+		wantErr    string
+	}{
+		{
+			name: "missing destination",
+			seed: func(t *testing.T, c *fakeClient) {
+				c.destinations["destination-1"] = nil
+			},
+			resource: NewDestinationResource(),
+			prior: func(t *testing.T) any {
+				return testDestinationModel(t, "destination-1", "existing", "s3://bucket/")
+			},
+			dataSource: NewDestinationDataSource(),
+			id:         "destination-1",
+			wantErr:    "Missing destination",
+		},
+		{
+			name: "missing workflow",
+			seed: func(t *testing.T, c *fakeClient) {
+				c.workflows["workflow-1"] = nil
+			},
+			resource: NewWorkflowResource(),
+			prior: func(t *testing.T) any {
+				return testWorkflowModel(t, "workflow-1", "existing", "source-1")
+			},
+			dataSource: NewWorkflowDataSource(),
+			id:         "workflow-1",
+			wantErr:    "Missing workflow",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Run("resource", func(t *testing.T) {
+				c := newFakeClient()
+				tt.seed(t, c)
+
+				empty := testResource(t, tt.resource, c)
+				_, diags := testCRUD(t, tt.resource, empty, "Read", tt.prior(t), nil)
+
+				if !diags.HasError() || !strings.Contains(fmt.Sprint(diags), tt.wantErr) {
+					t.Fatalf("Read() diagnostics = %v, want %q", diags, tt.wantErr)
+				}
+			})
+
+			t.Run("data source", func(t *testing.T) {
+				c := newFakeClient()
+				tt.seed(t, c)
+
+				_, diags := testDataSourceRead(t, tt.dataSource, c, tt.id)
+
+				if !diags.HasError() || !strings.Contains(fmt.Sprint(diags), tt.wantErr) {
+					t.Fatalf("Read() diagnostics = %v, want %q", diags, tt.wantErr)
+				}
+			})
+		})
+	}
+}
+
+// TestUnsupportedConnectorTypes checks that sources and destinations whose
+// connector type has no block, or does not match their config, read with a
+// warning and their raw config rather than with every connector block null.
+func TestUnsupportedConnectorTypes(t *testing.T) {
+	tests := []struct {
+		name       string
+		seed       func(t *testing.T, c *fakeClient)
+		resource   resource.Resource
+		prior      func(t *testing.T) any
+		dataSource datasource.DataSource
+		id         string
+		kind       string
+		typ        string
+		config     string
+	}{
+		{
+			name: "unknown source type",
+			seed: func(t *testing.T, c *fakeClient) {
+				var source unstructured.Source
+				if err := json.Unmarshal([]byte(`{"id": "source-1", "name": "slack", "type": "slack", "config": {"channels": ["general"], "token": "t"}}`), &source); err != nil {
+					t.Fatal(err)
+				}
+				c.sources["source-1"] = &source
+			},
+			resource: NewSourceResource(),
+			prior: func(t *testing.T) any {
+				return testSourceModel(t, "source-1", "slack", "s3://bucket/")
+			},
+			dataSource: NewSourceDataSource(),
+			id:         "source-1",
+			kind:       "source",
+			typ:        "slack",
+			config:     `{"channels":["general"],"token":"t"}`,
+		},
+		{
+			name: "mismatched source config",
+			seed: func(t *testing.T, c *fakeClient) {
+				c.sources["source-1"] = &unstructured.Source{ID: "source-1", Name: "slack", Type: unstructured.ConnectorTypeSlack, Config: &unstructured.S3SourceConnectorConfig{RemoteURL: "s3://bucket/"}}
+			},
+			resource: NewSourceResource(),
+			prior: func(t *testing.T) any {
+				return testSourceModel(t, "source-1", "slack", "s3://bucket/")
+			},
+			dataSource: NewSourceDataSource(),
+			id:         "source-1",
+			kind:       "source",
+			typ:        "slack",
+			config:     `{"remote_url":"s3://bucket/","anonymous":false,"recursive":false}`,
+		},
+		{
+			name: "mismatched destination config",
+			seed: func(t *testing.T, c *fakeClient) {
+				c.destinations["destination-1"] = &unstructured.Destination{ID: "destination-1", Name: "vectors", Type: unstructured.ConnectorTypePinecone, Config: &unstructured.S3DestinationConnectorConfig{RemoteURL: "s3://bucket/"}}
+			},
+			resource: NewDestinationResource(),
+			prior: func(t *testing.T) any {
+				return testDestinationModel(t, "destination-1", "vectors", "s3://bucket/")
+			},
+			dataSource: NewDestinationDataSource(),
+			id:         "destination-1",
+			kind:       "destination",
+			typ:        "pinecone",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Each read must warn and must not error
+			checkDiags := func(t *testing.T, diags diag.Diagnostics) {
+				t.Helper()
+
+				if diags.HasError() || diags.WarningsCount() != 1 || !strings.Contains(fmt.Sprint(diags), "Unsupported "+tt.kind+" type") {
+					t.Fatalf("Read() diagnostics = %v, want an unsupported %s type warning", diags, tt.kind)
+				}
+
+				if !strings.Contains(fmt.Sprint(diags), strconv.Quote(tt.typ)) {
+					t.Errorf("Read() diagnostics = %v, want the type %q", diags, tt.typ)
+				}
+			}
+
+			checkConfig := func(t *testing.T, got types.String) {
+				t.Helper()
+
+				if got.IsNull() || (tt.config != "" && got.ValueString() != tt.config) {
+					t.Errorf("config_json = %s, want %s", got, tt.config)
+				}
+			}
+
+			t.Run("resource", func(t *testing.T) {
+				c := newFakeClient()
+				tt.seed(t, c)
+
+				empty := testResource(t, tt.resource, c)
+				state, diags := testCRUD(t, tt.resource, empty, "Read", tt.prior(t), nil)
+				checkDiags(t, diags)
+
+				var typ types.String
+				var config jsontypes.Normalized
+				state.GetAttribute(t.Context(), path.Root("custom").AtName("type"), &typ)
+				state.GetAttribute(t.Context(), path.Root("custom").AtName("config_json"), &config)
+
+				if typ.ValueString() != tt.typ {
+					t.Errorf("custom.type = %s, want %s", typ, tt.typ)
+				}
+				checkConfig(t, config.StringValue)
+
+				var s3 types.Object
+				if state.GetAttribute(t.Context(), path.Root("s3"), &s3); !s3.IsNull() {
+					t.Errorf("s3 = %s, want null", s3)
+				}
+			})
+
+			t.Run("data source", func(t *testing.T) {
+				c := newFakeClient()
+				tt.seed(t, c)
+
+				state, diags := testDataSourceRead(t, tt.dataSource, c, tt.id)
+				checkDiags(t, diags)
+
+				var config types.String
+				state.GetAttribute(t.Context(), path.Root("config_json"), &config)
+				checkConfig(t, config)
+			})
+		})
+	}
+}
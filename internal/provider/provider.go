@@ -4,19 +4,27 @@
 package provider
 
 import (
+	"cmp"
 	"context"
-	"os"
+	"fmt"
+	"net/http"
+	"strings"
 
 	"github.com/aws-gopher/unstructured-sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure Provider satisfies various provider interfaces.
 var _ provider.Provider = &Provider{}
+var _ provider.ProviderWithListResources = &Provider{}
 
 // Provider defines the provider implementation.
 type Provider struct {
@@ -25,14 +33,66 @@ type Provider struct {
 	// testing.
 	version string
 	client  *unstructured.Client
+
+	// transport, when set, wraps the HTTP transport of the configured
+	// client. Tests use it to record and replay API interactions.
+	transport func(http.RoundTripper) http.RoundTripper
 }
 
 // ProviderModel describes the provider data model.
 type ProviderModel struct {
-	APIKey   types.String `tfsdk:"api_key"`
-	Endpoint types.String `tfsdk:"endpoint"`
+	APIKey                    types.String `tfsdk:"api_key"`
+	Endpoint                  types.String `tfsdk:"endpoint"`
+	Profile                   types.String `tfsdk:"profile"`
+	CredentialsFile           types.String `tfsdk:"credentials_file"`
+	CredentialProcess         types.String `tfsdk:"credential_process"`
+	SkipCredentialsValidation types.Bool   `tfsdk:"skip_credentials_validation"`
+	ProxyURL                  types.String `tfsdk:"proxy_url"`
+	CACertPEM                 types.String `tfsdk:"ca_cert_pem"`
+	CACertFile                types.String `tfsdk:"ca_cert_file"`
+	ClientCertPEM             types.String `tfsdk:"client_cert_pem"`
+	ClientKeyPEM              types.String `tfsdk:"client_key_pem"`
+	InsecureSkipVerify        types.Bool   `tfsdk:"insecure_skip_verify"`
+	Headers                   types.Map    `tfsdk:"headers"`
+	DeletionProtection        types.Bool   `tfsdk:"deletion_protection"`
 }
 
+// defaultEndpoint is the endpoint the SDK uses when none is configured.
+const defaultEndpoint = "https://platform.unstructuredapp.io/api/v1"
+
+// providerDescription documents how the provider finds its credentials.
+const providerDescription = `The Unstructured provider manages workflows, sources and destinations on the Unstructured platform.
+
+## Authentication
+
+The API key and endpoint are each taken from the first of the following that sets them:
+
+1. The ` + "`api_key` and `endpoint`" + ` provider attributes.
+2. The output of the ` + "`credential_process`" + ` command.
+3. The ` + "`UNSTRUCTURED_API_KEY` and `UNSTRUCTURED_API_URL`" + ` environment variables.
+4. The selected profile of the shared credentials file.
+
+The shared credentials file defaults to ` + "`~/.config/unstructured/credentials`" + ` and holds named profiles:
+
+` + "```ini" + `
+[default]
+api_key = ...
+
+[prod]
+api_key  = ...
+endpoint = https://platform.unstructuredapp.io/api/v1
+` + "```" + `
+
+The profile is selected with the ` + "`profile`" + ` attribute or the ` + "`UNSTRUCTURED_PROFILE`" + ` environment variable, and defaults to ` + "`default`" + `.
+
+To keep the API key in a secrets manager, set ` + "`credential_process`" + ` to a command that prints the key as JSON:
+
+` + "```json" + `
+{"api_key": "...", "endpoint": "https://platform.unstructuredapp.io/api/v1"}
+` + "```" + `
+
+The ` + "`endpoint`" + ` field is optional. The command runs at most once per provider process.`
+
 func (p *Provider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = "unstructured"
 	resp.Version = p.version
@@ -40,6 +100,7 @@ func (p *Provider) Metadata(ctx context.Context, req provider.MetadataRequest, r
 
 func (p *Provider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		MarkdownDescription: providerDescription,
 		Attributes: map[string]schema.Attribute{
 			"api_key": schema.StringAttribute{
 				Optional:    true,
@@ -50,6 +111,68 @@ func (p *Provider) Schema(ctx context.Context, req provider.SchemaRequest, resp
 				Optional:    true,
 				Description: "The endpoint of the API",
 			},
+			"profile": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the profile in the shared credentials file to read the API key and endpoint from. Can also be set with the UNSTRUCTURED_PROFILE environment variable. Defaults to \"default\"",
+			},
+			"credentials_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to the shared credentials file. Can also be set with the UNSTRUCTURED_CREDENTIALS_FILE environment variable. Defaults to ~/.config/unstructured/credentials",
+			},
+			"credential_process": schema.StringAttribute{
+				Optional:    true,
+				Description: "Command to run to obtain the API key. It must print a JSON object with an api_key and an optional endpoint to stdout. The command runs at most once per provider process",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("api_key")),
+				},
+			},
+			"proxy_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "URL of an HTTP(S) proxy to send API requests through. Defaults to the proxy configured by the HTTPS_PROXY and NO_PROXY environment variables",
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Optional:    true,
+				Description: "PEM-encoded CA certificates to trust in addition to the system roots",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_cert_file")),
+				},
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a file of PEM-encoded CA certificates to trust in addition to the system roots",
+			},
+			"client_cert_pem": schema.StringAttribute{
+				Optional:    true,
+				Description: "PEM-encoded client certificate for mutual TLS",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_key_pem")),
+				},
+			},
+			"client_key_pem": schema.StringAttribute{
+				Optional:    true,
+				Description: "PEM-encoded private key for client_cert_pem",
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_cert_pem")),
+				},
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Skip verification of the API server's TLS certificate. Only use this for testing",
+			},
+			"headers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Additional HTTP headers to send with every API request, for example routing headers required by an API gateway",
+			},
+			"skip_credentials_validation": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Skip checking the API key and endpoint with a request to the API when the provider is configured. Useful for offline plans",
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional:    true,
+				Description: "The default of `deletion_protection` for the sources, destinations and workflows that do not set it. Defaults to false",
+			},
 		},
 	}
 }
@@ -59,36 +182,49 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 
 	opts := []unstructured.Option{}
 
-	// Check environment variables
-	apiKey := os.Getenv("UNSTRUCTURED_API_KEY")
-	endpoint := os.Getenv("UNSTRUCTURED_API_URL")
-
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Check configuration data, which should take precedence over
-	// environment variable data, if found.
-	if data.APIKey.ValueString() != "" {
-		apiKey = data.APIKey.ValueString()
+	// Resolve the API key and endpoint from the configuration, the
+	// credential process, the environment and the shared credentials file,
+	// in that order.
+	creds, err := resolveCredentials(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Resolve Credentials",
+			err.Error(),
+		)
+		return
 	}
 
-	if data.Endpoint.ValueString() != "" {
-		endpoint = data.Endpoint.ValueString()
-	}
+	apiKey := creds.APIKey
+	endpoint := creds.Endpoint
 
 	if apiKey == "" {
 		resp.Diagnostics.AddError(
 			"Missing API Key Configuration",
 			"While configuring the provider, the API key was not found in "+
-				"the UNSTRUCTURED_API_KEY environment variable or provider "+
-				"configuration block api_key attribute.",
+				"the provider configuration block api_key attribute, the "+
+				"credential_process output, the UNSTRUCTURED_API_KEY "+
+				"environment variable or the selected profile of the shared "+
+				"credentials file.",
 		)
 		// Not returning early allows the logic to collect all errors.
 	}
 
+	if endpoint != "" {
+		if err := validateEndpoint(endpoint); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("endpoint"),
+				"Invalid Endpoint Configuration",
+				err.Error(),
+			)
+		}
+	}
+
 	// Create data/clients and persist to resp.DataSourceData, resp.ResourceData,
 	// and resp.EphemeralResourceData as appropriate.
 
@@ -100,6 +236,51 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 		opts = append(opts, unstructured.WithEndpoint(endpoint))
 	}
 
+	var headers map[string]string
+	if !data.Headers.IsNull() && !data.Headers.IsUnknown() {
+		resp.Diagnostics.Append(data.Headers.ElementsAs(ctx, &headers, false)...)
+	}
+
+	for k := range headers {
+		if strings.EqualFold(k, unstructured.HeaderKey) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("headers"),
+				"Invalid Headers Configuration",
+				fmt.Sprintf("The %s header carries the API key and cannot be set in headers. Use api_key instead.", unstructured.HeaderKey),
+			)
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hc, err := newHTTPClient(transportConfig{
+		ProxyURL:           data.ProxyURL.ValueString(),
+		CACertPEM:          data.CACertPEM.ValueString(),
+		CACertFile:         data.CACertFile.ValueString(),
+		ClientCertPEM:      data.ClientCertPEM.ValueString(),
+		ClientKeyPEM:       data.ClientKeyPEM.ValueString(),
+		InsecureSkipVerify: data.InsecureSkipVerify.ValueBool(),
+		UserAgent:          userAgent(p.version, req.TerraformVersion),
+		Headers:            headers,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid HTTP Transport Configuration",
+			err.Error(),
+		)
+		return
+	}
+
+	if p.transport != nil {
+		hc.Transport = p.transport(hc.Transport)
+	}
+
+	// The client must be set before the key, since WithKey wraps the
+	// client's transport.
+	opts = append([]unstructured.Option{unstructured.WithClient(hc)}, opts...)
+
 	client, err := unstructured.New(opts...)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -107,9 +288,32 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 			err.Error(),
 		)
 	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Catch a mistyped endpoint or a revoked key here, rather than in the
+	// first resource that calls the API.
+	if !data.SkipCredentialsValidation.ValueBool() {
+		if err := checkCredentials(ctx, client); err != nil {
+			resp.Diagnostics.AddError(describeCredentialsError(cmp.Or(endpoint, defaultEndpoint), err))
+			return
+		}
+	}
+
+	// Resource identities record the endpoint, without a trailing slash so
+	// that equivalent endpoints give the same identity.
+	c := &endpointClient{
+		client:             client,
+		endpoint:           strings.TrimSuffix(cmp.Or(endpoint, defaultEndpoint), "/"),
+		deletionProtection: data.DeletionProtection.ValueBool(),
+	}
+
 	p.client = client
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	resp.DataSourceData = c
+	resp.ResourceData = c
+	resp.ListResourceData = c
 }
 
 func (p *Provider) Resources(ctx context.Context) []func() resource.Resource {
@@ -125,6 +329,17 @@ func (p *Provider) DataSources(ctx context.Context) []func() datasource.DataSour
 		NewWorkflowDataSource,
 		NewSourceDataSource,
 		NewDestinationDataSource,
+		NewWorkflowsDataSource,
+		NewSourcesDataSource,
+		NewDestinationsDataSource,
+	}
+}
+
+func (p *Provider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewWorkflowListResource,
+		NewSourceListResource,
+		NewDestinationListResource,
 	}
 }
 
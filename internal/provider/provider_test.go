@@ -4,22 +4,256 @@
 package provider
 
 import (
+	"errors"
+	"fmt"
+	"io/fs"
 	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
 
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/recorder"
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/unstructuredtest"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const (
+	// envRecord enables re-recording the cassettes of acceptance tests
+	// against the live API.
+	envRecord = "UNSTRUCTURED_RECORD"
+
+	// envFakeAPI runs acceptance tests against an in-memory fake of the API
+	// instead of replaying their cassettes.
+	envFakeAPI = "UNSTRUCTURED_FAKE_API"
 )
 
+// testAccRecorders holds the recorder of each running acceptance test.
+var testAccRecorders sync.Map
+
 // testAccProtoV6ProviderFactories is used to instantiate a provider during acceptance testing.
 // The factory function is called for each Terraform CLI command to create a provider
-// server that the CLI can connect to and interact with.
-var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
-	"unstructured": providerserver.NewProtocol6WithError(New("test")()),
+// server that the CLI can connect to and interact with. When the test replays
+// or records a cassette, the provider's requests go through the test's
+// recorder.
+func testAccProtoV6ProviderFactories(t *testing.T) map[string]func() (tfprotov6.ProviderServer, error) {
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"unstructured": func() (tfprotov6.ProviderServer, error) {
+			p := &Provider{version: "test"}
+			if rec := testAccRecorder(t); rec != nil {
+				p.transport = rec.Wrap
+			}
+
+			return providerserver.NewProtocol6WithError(p)()
+		},
+	}
+}
+
+// testAccCassette returns the path of the cassette recorded for a test.
+func testAccCassette(t *testing.T) string {
+	return filepath.Join("testdata", "cassettes", strings.ReplaceAll(t.Name(), "/", "_")+".json")
+}
+
+// testAccRecorder returns the recorder for a test, creating it on first use.
+// It fails the test if the test should replay a cassette that doesn't exist.
+func testAccRecorder(t *testing.T) *recorder.Recorder {
+	t.Helper()
+
+	if rec, ok := testAccRecorders.Load(t); ok {
+		rec, _ := rec.(*recorder.Recorder)
+		return rec
+	}
+
+	rec, err := newTestAccRecorder(testAccCassette(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testAccRecorders.Store(t, rec)
+	t.Cleanup(func() {
+		testAccRecorders.Delete(t)
+
+		if rec == nil {
+			return
+		}
+
+		if err := rec.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	return rec
+}
+
+// newTestAccRecorder returns the recorder for the cassette at path. It
+// records when UNSTRUCTURED_RECORD is set, returns nil when the live API or
+// the fake is used instead, and replays otherwise.
+func newTestAccRecorder(path string) (*recorder.Recorder, error) {
+	switch {
+	case os.Getenv(envRecord) != "":
+		return recorder.New(path, recorder.ModeRecord, nil)
+
+	case os.Getenv(envAPIKey) != "", os.Getenv(envFakeAPI) != "":
+		return nil, nil
+	}
+
+	if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("cassette %s does not exist: record it with `make testacc RECORD=1`, or set %s to test against the fake API", path, envFakeAPI)
+	}
+
+	return recorder.New(path, recorder.ModeReplay, nil)
+}
+
+func TestNewTestAccRecorder(t *testing.T) {
+	dir := t.TempDir()
+	cassette := filepath.Join(dir, "cassette.json")
+
+	if err := os.WriteFile(cassette, []byte(`{"interactions":[]}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		env     map[string]string
+		path    string
+		want    recorder.Mode
+		wantNil bool
+		wantErr bool
+	}{
+		{
+			name: "replay",
+			path: cassette,
+			want: recorder.ModeReplay,
+		},
+		{
+			name:    "replay missing cassette",
+			path:    filepath.Join(dir, "missing.json"),
+			wantErr: true,
+		},
+		{
+			name: "record",
+			env:  map[string]string{envRecord: "1", envAPIKey: "key"},
+			path: filepath.Join(dir, "missing.json"),
+			want: recorder.ModeRecord,
+		},
+		{
+			name:    "live",
+			env:     map[string]string{envAPIKey: "key"},
+			path:    filepath.Join(dir, "missing.json"),
+			wantNil: true,
+		},
+		{
+			name:    "fake",
+			env:     map[string]string{envFakeAPI: "1"},
+			path:    filepath.Join(dir, "missing.json"),
+			wantNil: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, name := range []string{envRecord, envAPIKey, envFakeAPI} {
+				t.Setenv(name, tt.env[name])
+			}
+
+			rec, err := newTestAccRecorder(tt.path)
+
+			switch {
+			case tt.wantErr:
+				if err == nil {
+					t.Error("expected an error")
+				}
+
+			case err != nil:
+				t.Fatalf("newTestAccRecorder() error = %v", err)
+
+			case tt.wantNil:
+				if rec != nil {
+					t.Errorf("newTestAccRecorder() = %v, want nil", rec)
+				}
+
+			case rec == nil:
+				t.Error("newTestAccRecorder() = nil")
+
+			case rec.Mode() != tt.want:
+				t.Errorf("newTestAccRecorder() mode = %v, want %v", rec.Mode(), tt.want)
+			}
+		})
+	}
 }
 
+// testAccPreCheck points the provider at the API for an acceptance test.
+// Tests replay their cassette without network access by default. The live
+// API is used instead when UNSTRUCTURED_API_KEY is set, and a fake API server
+// that lives as long as the test when UNSTRUCTURED_FAKE_API is. Recording
+// requires the live API.
 func testAccPreCheck(t *testing.T) {
-	if v := os.Getenv("UNSTRUCTURED_API_KEY"); v == "" {
-		t.Fatal("UNSTRUCTURED_API_KEY environment variable must be set for acceptance tests")
+	t.Helper()
+
+	rec := testAccRecorder(t)
+
+	switch {
+	case rec != nil && rec.Mode() == recorder.ModeRecord:
+		if os.Getenv(envAPIKey) == "" {
+			t.Fatalf("%s must be set to record cassettes", envAPIKey)
+		}
+
+	case rec != nil:
+		t.Setenv(envAPIKey, "replay")
+		t.Setenv(envEndpoint, defaultEndpoint)
+
+	case os.Getenv(envAPIKey) == "":
+		srv := unstructuredtest.NewServer()
+		t.Cleanup(srv.Close)
+
+		t.Setenv(envAPIKey, unstructuredtest.APIKey)
+		t.Setenv(envEndpoint, srv.Endpoint())
 	}
 }
+
+// testProviderConfig builds the provider configuration from the given
+// attribute values, leaving every other attribute null.
+func testProviderConfig(t *testing.T, values map[string]tftypes.Value) tfsdk.Config {
+	t.Helper()
+
+	var resp provider.SchemaResponse
+	New("test")().Schema(t.Context(), provider.SchemaRequest{}, &resp)
+
+	typ, ok := resp.Schema.Type().TerraformType(t.Context()).(tftypes.Object)
+	if !ok {
+		t.Fatal("provider schema is not an object")
+	}
+
+	attrs := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+	for name, attrType := range typ.AttributeTypes {
+		attrs[name] = tftypes.NewValue(attrType, nil)
+	}
+
+	for name, v := range values {
+		attrs[name] = v
+	}
+
+	return tfsdk.Config{
+		Schema: resp.Schema,
+		Raw:    tftypes.NewValue(typ, attrs),
+	}
+}
+
+// testConfigureProvider runs the provider's Configure with the given
+// attribute values.
+func testConfigureProvider(t *testing.T, values map[string]tftypes.Value) *provider.ConfigureResponse {
+	t.Helper()
+
+	resp := &provider.ConfigureResponse{}
+	New("test")().Configure(t.Context(), provider.ConfigureRequest{
+		Config:           testProviderConfig(t, values),
+		TerraformVersion: "1.12.0",
+	}, resp)
+
+	return resp
+}
@@ -4,20 +4,23 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/convert"
 	"github.com/aws-gopher/terraform-provider-unstructured/internal/datasource_source"
 	"github.com/aws-gopher/unstructured-sdk-go"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 )
 
 var _ datasource.DataSource = (*sourceDataSource)(nil)
 var _ datasource.DataSourceWithConfigure = (*sourceDataSource)(nil)
+var _ datasource.DataSourceWithConfigValidators = (*sourceDataSource)(nil)
 
 func NewSourceDataSource() datasource.DataSource {
 	return &sourceDataSource{}
 }
 
 type sourceDataSource struct {
-	client *unstructured.Client
+	client client
 }
 
 func (d *sourceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -33,16 +36,23 @@ func (d *sourceDataSource) Configure(ctx context.Context, req datasource.Configu
 		return
 	}
 
-	client, ok := req.ProviderData.(*unstructured.Client)
+	c, ok := req.ProviderData.(client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *unstructured.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected an Unstructured API client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = c
+}
+
+// ConfigValidators implements datasource.DataSourceWithConfigValidators.
+func (d *sourceDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		idOrName(),
+	}
 }
 
 func (d *sourceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -55,16 +65,61 @@ func (d *sourceDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	// Get the source by ID
-	source, err := d.client.GetSource(ctx, data.Id.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Error getting source", err.Error())
+	// Get the source by ID, or find it by name
+	source := d.lookup(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Set state
-	resp.Diagnostics.Append(resp.State.Set(ctx, datasource_source.SourceToModel(ctx, source, resp.Diagnostics))...)
+	model, diags := datasource_source.SourceToModel(ctx, source)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// lookup gets the source by ID, or finds it by name when no ID is set. Either
+// way, the source must have the connector type set in data, if any.
+func (d *sourceDataSource) lookup(ctx context.Context, data *datasource_source.SourceModel, diags *diag.Diagnostics) *unstructured.Source {
+	// The type may also be given as the name of a connector block
+	typ := data.Type.ValueString()
+	if t := convert.SourceConnectorType(typ); t != "" {
+		typ = t
+	}
+
+	if !data.Id.IsNull() {
+		source, err := d.client.GetSource(ctx, data.Id.ValueString())
+		if err != nil {
+			diags.AddError("Error getting source", err.Error())
+			return nil
+		}
+
+		if source != nil {
+			checkConnectorType("source", source.ID, source.Type, typ, diags)
+		}
+
+		return source
+	}
+
+	// The API lists only the sources of the configured connector type, if any
+	sources, err := d.client.ListSources(ctx, typ)
+	if err != nil {
+		diags.AddError("Error listing sources", err.Error())
+		return nil
+	}
+
+	kind := "source"
+	if typ != "" {
+		kind = typ + " source"
+	}
+
+	return findByName(kind, data.Name.ValueString(), sources, func(source *unstructured.Source) (string, string) {
+		return source.ID, source.Name
+	}, diags)
 }
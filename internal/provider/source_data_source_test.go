@@ -4,9 +4,9 @@
 package provider
 
 import (
-	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-testing/compare"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
@@ -16,26 +16,30 @@ import (
 func TestAccSourceDataSource(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(t),
 		Steps: []resource.TestStep{
 			// Read testing
 			{
 				Config: testAccSourceDataSourceConfig,
 				ConfigStateChecks: []statecheck.StateCheck{
-					statecheck.ExpectKnownValue(
+					statecheck.CompareValuePairs(
 						"data.unstructured_source.test",
 						tfjsonpath.New("id"),
-						knownvalue.StringExact("f49d1dcc-99f4-4be1-9afe-ce2495654ffb"),
+						"unstructured_source.test",
+						tfjsonpath.New("id"),
+						compare.ValuesSame(),
 					),
 					statecheck.ExpectKnownValue(
 						"data.unstructured_source.test",
 						tfjsonpath.New("name"),
-						knownvalue.StringFunc(func(v string) error {
-							if v == "" {
-								return fmt.Errorf("expected name to not be empty")
-							}
-							return nil
-						}),
+						knownvalue.StringExact("Terraform Test Source Data Source"),
+					),
+					statecheck.CompareValuePairs(
+						"data.unstructured_source.by_name",
+						tfjsonpath.New("id"),
+						"unstructured_source.test",
+						tfjsonpath.New("id"),
+						compare.ValuesSame(),
 					),
 				},
 			},
@@ -44,7 +48,20 @@ func TestAccSourceDataSource(t *testing.T) {
 }
 
 const testAccSourceDataSourceConfig = `
+resource "unstructured_source" "test" {
+  name = "Terraform Test Source Data Source"
+
+  s3 = {
+    remote_url = "s3://example-bucket/"
+    anonymous  = true
+  }
+}
+
 data "unstructured_source" "test" {
-  id = "f49d1dcc-99f4-4be1-9afe-ce2495654ffb"
+  id = unstructured_source.test.id
+}
+
+data "unstructured_source" "by_name" {
+  name = unstructured_source.test.name
 }
 `
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/convert"
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/resource_source"
+	"github.com/aws-gopher/unstructured-sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ list.ListResource = (*sourceListResource)(nil)
+var _ list.ListResourceWithConfigure = (*sourceListResource)(nil)
+
+func NewSourceListResource() list.ListResource {
+	return &sourceListResource{}
+}
+
+type sourceListResource struct {
+	client client
+}
+
+type sourceListResourceModel struct {
+	Type      types.String `tfsdk:"type"`
+	NameRegex types.String `tfsdk:"name_regex"`
+}
+
+func (r *sourceListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_source"
+}
+
+func (r *sourceListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the sources visible to the API key.",
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list sources of this connector type, named as the API or as the connector block names it, such as `kafka-cloud` or `kafka_cloud`",
+				Validators: []validator.String{
+					stringvalidator.OneOf(connectorTypeFilters(resource_source.SourceConnectors, convert.SourceConnectorType)...),
+				},
+			},
+			"name_regex": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list sources whose name matches this regular expression",
+			},
+		},
+	}
+}
+
+func (r *sourceListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if c := configureListResource(req, resp); c != nil {
+		r.client = c
+	}
+}
+
+func (r *sourceListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data sourceListResourceModel
+	var diags diag.Diagnostics
+
+	// Read the list configuration into the model
+	diags.Append(req.Config.Get(ctx, &data)...)
+
+	re := nameRegex(data.NameRegex, &diags)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	sources, unsupported, err := listSources(ctx, r.client, data.Type.ValueString(), re)
+	if err != nil {
+		diags.AddError("Error listing sources", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	if len(unsupported) > 0 {
+		diags.AddWarning(
+			"Unsupported source types",
+			"These sources have connector types without a connector block of their own, so their config was read into the custom block: "+strings.Join(unsupported, ", "),
+		)
+	}
+
+	stream.Results = listResults(ctx, r.client, req, diags, sources, func(s *unstructured.Source) (string, string) {
+		return s.ID, s.Name
+	}, resource_source.SourceToModel)
+}
@@ -4,13 +4,19 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/convert"
 	"github.com/aws-gopher/terraform-provider-unstructured/internal/resource_source"
 	"github.com/aws-gopher/unstructured-sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
 var _ resource.Resource = (*sourceResource)(nil)
 var _ resource.ResourceWithConfigure = (*sourceResource)(nil)
+var _ resource.ResourceWithIdentity = (*sourceResource)(nil)
+var _ resource.ResourceWithConfigValidators = (*sourceResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*sourceResource)(nil)
 var _ resource.ResourceWithImportState = (*sourceResource)(nil)
 
 func NewSourceResource() resource.Resource {
@@ -18,7 +24,7 @@ func NewSourceResource() resource.Resource {
 }
 
 type sourceResource struct {
-	client *unstructured.Client
+	client client
 }
 
 func (r *sourceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -26,7 +32,12 @@ func (r *sourceResource) Metadata(ctx context.Context, req resource.MetadataRequ
 }
 
 func (r *sourceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = resource_source.SourceResourceSchema(ctx)
+	resp.Schema = withConnectorValidators(resource_source.SourceResourceSchema(ctx), connectorAttributeValidators)
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (r *sourceResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema("source")
 }
 
 func (r *sourceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -34,156 +45,88 @@ func (r *sourceResource) Configure(ctx context.Context, req resource.ConfigureRe
 		return
 	}
 
-	client, ok := req.ProviderData.(*unstructured.Client)
+	c, ok := req.ProviderData.(client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *unstructured.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected an Unstructured API client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = c
 }
 
-// validateSourceConfig ensures only one nested block is provided.
-func (r *sourceResource) validateSourceConfig(data *resource_source.SourceModel) error {
-	configCount := 0
-	if !data.S3.IsNull() {
-		configCount++
-	}
-	if !data.Postgres.IsNull() {
-		configCount++
-	}
-	if !data.Azure.IsNull() {
-		configCount++
-	}
-	if !data.GoogleDrive.IsNull() {
-		configCount++
-	}
-	if !data.Salesforce.IsNull() {
-		configCount++
-	}
-
-	if configCount == 0 {
-		return fmt.Errorf("exactly one source configuration block must be provided (s3, postgres, azure, google_drive, or salesforce)")
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+func (r *sourceResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		exactlyOneConnector(resource_source.SourceConnectors),
+		customConnectorValidator{kind: "source", block: sourceConnectorBlock},
 	}
-	if configCount > 1 {
-		return fmt.Errorf("only one source configuration block can be provided (s3, postgres, azure, google_drive, or salesforce)")
-	}
-	return nil
 }
 
-// getSourceConfig converts the Terraform model to the appropriate API config.
-func (r *sourceResource) getSourceConfig(data *resource_source.SourceModel) (unstructured.SourceConfigInput, error) {
-	if !data.S3.IsNull() {
-		config := &unstructured.S3SourceConnectorConfigInput{
-			RemoteURL: data.S3.RemoteUrl.ValueString(),
-		}
-
-		if !data.S3.Anonymous.IsNull() && !data.S3.Anonymous.IsUnknown() {
-			anonymous := data.S3.Anonymous.ValueBool()
-			config.Anonymous = &anonymous
-		}
-		if !data.S3.Key.IsNull() && !data.S3.Key.IsUnknown() {
-			key := data.S3.Key.ValueString()
-			config.Key = &key
-		}
-		if !data.S3.Secret.IsNull() && !data.S3.Secret.IsUnknown() {
-			secret := data.S3.Secret.ValueString()
-			config.Secret = &secret
-		}
-		if !data.S3.Token.IsNull() && !data.S3.Token.IsUnknown() {
-			token := data.S3.Token.ValueString()
-			config.Token = &token
-		}
-		if !data.S3.EndpointUrl.IsNull() && !data.S3.EndpointUrl.IsUnknown() {
-			endpointUrl := data.S3.EndpointUrl.ValueString()
-			config.EndpointURL = &endpointUrl
-		}
-		if !data.S3.Recursive.IsNull() && !data.S3.Recursive.IsUnknown() {
-			recursive := data.S3.Recursive.ValueBool()
-			config.Recursive = &recursive
-		}
-
-		return config, nil
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+func (r *sourceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when destroying
+	if req.Plan.Raw.IsNull() {
+		return
 	}
 
-	if !data.Postgres.IsNull() {
-		config := &unstructured.PostgresSourceConnectorConfigInput{
-			Host:      data.Postgres.Host.ValueString(),
-			Database:  data.Postgres.Database.ValueString(),
-			Port:      int(data.Postgres.Port.ValueInt64()),
-			Username:  data.Postgres.Username.ValueString(),
-			Password:  data.Postgres.Password.ValueString(),
-			TableName: data.Postgres.TableName.ValueString(),
-			BatchSize: int(data.Postgres.BatchSize.ValueInt64()),
-		}
-		if !data.Postgres.IdColumn.IsNull() && !data.Postgres.IdColumn.IsUnknown() {
-			idColumn := data.Postgres.IdColumn.ValueString()
-			config.IDColumn = &idColumn
-		}
-
-		// Convert fields list
-		if !data.Postgres.Fields.IsNull() && !data.Postgres.Fields.IsUnknown() {
-			var fields []string
-			data.Postgres.Fields.ElementsAs(context.Background(), &fields, false)
-			config.Fields = fields
-		}
-
-		return config, nil
+	planDeletionProtection(ctx, r.client, req, resp)
+
+	var plan resource_source.SourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if !data.Azure.IsNull() {
-		config := &unstructured.AzureSourceConnectorConfigInput{
-			RemoteURL: data.Azure.RemoteUrl.ValueString(),
-		}
-		if !data.Azure.ConnectionString.IsNull() && !data.Azure.ConnectionString.IsUnknown() {
-			connectionString := data.Azure.ConnectionString.ValueString()
-			config.ConnectionString = &connectionString
-		}
-		return config, nil
+	// The connector type follows from the connector block
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("type"),
+		plannedConnectorType(resource_source.SourceConnectorBlocks(&plan), plan.Custom, convert.SourceConnectorType))...)
+
+	// Nothing to replace when creating
+	if req.State.Raw.IsNull() {
+		return
 	}
 
-	if !data.GoogleDrive.IsNull() {
-		config := &unstructured.GoogleDriveSourceConnectorConfigInput{
-			DriveID: data.GoogleDrive.DriveId.ValueString(),
-		}
-		if !data.GoogleDrive.ServiceAccountKey.IsNull() && !data.GoogleDrive.ServiceAccountKey.IsUnknown() {
-			serviceAccountKey := data.GoogleDrive.ServiceAccountKey.ValueString()
-			config.ServiceAccountKey = &serviceAccountKey
-		}
-
-		if !data.GoogleDrive.Extensions.IsNull() && !data.GoogleDrive.Extensions.IsUnknown() {
-			var extensions []string
-			data.GoogleDrive.Extensions.ElementsAs(context.Background(), &extensions, false)
-			config.Extensions = extensions
-		}
-		if !data.GoogleDrive.Recursive.IsNull() && !data.GoogleDrive.Recursive.IsUnknown() {
-			recursive := data.GoogleDrive.Recursive.ValueBool()
-			config.Recursive = &recursive
-		}
-
-		return config, nil
+	var state resource_source.SourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if !data.Salesforce.IsNull() {
-		config := &unstructured.SalesforceSourceConnectorConfigInput{
-			Username:    data.Salesforce.Username.ValueString(),
-			ConsumerKey: data.Salesforce.ConsumerKey.ValueString(),
-			PrivateKey:  data.Salesforce.PrivateKey.ValueString(),
-		}
+	requireConnectorReplace("source",
+		withCustomConnector(resource_source.SourceConnectorBlocks(&state), state.Custom),
+		withCustomConnector(resource_source.SourceConnectorBlocks(&plan), plan.Custom),
+		resp)
+	requireCustomTypeReplace("source", state.Custom, plan.Custom, resp)
+}
 
-		if !data.Salesforce.Categories.IsNull() && !data.Salesforce.Categories.IsUnknown() {
-			var categories []string
-			data.Salesforce.Categories.ElementsAs(context.Background(), &categories, false)
-			config.Categories = categories
-		}
+// getSourceConfig converts the Terraform model to the appropriate API config.
+func (r *sourceResource) getSourceConfig(ctx context.Context, data *resource_source.SourceModel) (unstructured.SourceConfigInput, diag.Diagnostics) {
+	if !data.Custom.IsNull() {
+		return customConfigInput(data.Custom), nil
+	}
 
-		return config, nil
+	config, diags := resource_source.SourceConfigInput(ctx, data)
+	if config == nil && !diags.HasError() {
+		diags.AddError("Error creating source configuration", "no valid source configuration found")
 	}
 
-	return nil, fmt.Errorf("no valid source configuration found")
+	return config, diags
+}
+
+// carryOverUnstoredAttributes sets the attributes of model, as read from the
+// API, that the API does not store to their values in data, the planned or
+// prior state: the connection check and deletion protection settings, the
+// custom connector config while the API config still matches it, and the
+// connector attributes the API does not return.
+func (r *sourceResource) carryOverUnstoredAttributes(model, data *resource_source.SourceModel) {
+	model.CheckConnection = data.CheckConnection
+	model.CheckConnectionMode = data.CheckConnectionMode
+	model.DeletionProtection = data.DeletionProtection
+	model.Custom = keepCustomConfig(data.Custom, model.Custom)
+	resource_source.KeepSourceUnstoredAttributes(model, data)
 }
 
 func (r *sourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -196,16 +139,10 @@ func (r *sourceResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	// Validate that exactly one source configuration is provided
-	if err := r.validateSourceConfig(&data); err != nil {
-		resp.Diagnostics.AddError("Invalid source configuration", err.Error())
-		return
-	}
-
 	// Get the source configuration
-	config, err := r.getSourceConfig(&data)
-	if err != nil {
-		resp.Diagnostics.AddError("Error creating source configuration", err.Error())
+	config, diags := r.getSourceConfig(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -220,7 +157,23 @@ func (r *sourceResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	// Save data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, resource_source.SourceToModel(ctx, source, resp.Diagnostics))...)
+	model, diags := resource_source.SourceToModel(ctx, source)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.carryOverUnstoredAttributes(model, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, r.client, model.Id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check the connection once the source is in state, so that a failed check
+	// does not lose track of it
+	resp.Diagnostics.Append(checkConnection(ctx, "source", model.Id.ValueString(), data.CheckConnection, data.CheckConnectionMode, r.client.CreateSourceConnectionCheck, r.client.GetSourceConnectionCheck)...)
 }
 
 func (r *sourceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -241,7 +194,21 @@ func (r *sourceResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	// Set state
-	resp.Diagnostics.Append(resp.State.Set(ctx, resource_source.SourceToModel(ctx, source, resp.Diagnostics))...)
+	model, diags := resource_source.SourceToModel(ctx, source)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(unsupportedConnectorWarning("source", model.Id.ValueString(), data.Custom, model.Custom)...)
+
+	r.carryOverUnstoredAttributes(model, &data)
+
+	// State without the setting, as after an import, takes the provider default
+	model.DeletionProtection = deletionProtection(r.client, data.DeletionProtection)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	resp.Diagnostics.Append(keepIdentity(ctx, resp.Identity, r.client, model.Id)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -263,21 +230,16 @@ func (r *sourceResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	// Validate that exactly one source configuration is provided
-	if err := r.validateSourceConfig(&data); err != nil {
-		resp.Diagnostics.AddError("Invalid source configuration", err.Error())
-		return
-	}
-
 	// Get the source configuration
-	config, err := r.getSourceConfig(&data)
-	if err != nil {
-		resp.Diagnostics.AddError("Error creating source configuration", err.Error())
+	config, diags := r.getSourceConfig(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Update the source
 	source, err := r.client.UpdateSource(ctx, unstructured.UpdateSourceRequest{
+		ID:     state.Id.ValueString(),
 		Config: config,
 	})
 	if err != nil {
@@ -286,7 +248,23 @@ func (r *sourceResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, resource_source.SourceToModel(ctx, source, resp.Diagnostics))...)
+	model, diags := resource_source.SourceToModel(ctx, source)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.carryOverUnstoredAttributes(model, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	resp.Diagnostics.Append(keepIdentity(ctx, resp.Identity, r.client, model.Id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check the connection once the source is in state, so that a failed check
+	// does not lose track of it
+	resp.Diagnostics.Append(checkConnection(ctx, "source", model.Id.ValueString(), data.CheckConnection, data.CheckConnectionMode, r.client.CreateSourceConnectionCheck, r.client.GetSourceConnectionCheck)...)
 }
 
 func (r *sourceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -299,6 +277,12 @@ func (r *sourceResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	// A protected source is kept until deletion_protection is turned off
+	resp.Diagnostics.Append(checkDeletionProtection("source", data.Id.ValueString(), data.DeletionProtection)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Delete the source
 	err := r.client.DeleteSource(ctx, data.Id.ValueString())
 	if err != nil {
@@ -309,12 +293,29 @@ func (r *sourceResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 // ImportState implements resource.ResourceWithImportState.
 func (r *sourceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import by ID
-	source, err := r.client.GetSource(ctx, req.ID)
+	// Import by ID, or by the id of the import identity
+	id := importID(ctx, "source", r.client, req, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	source, err := r.client.GetSource(ctx, id)
 	if err != nil {
 		resp.Diagnostics.AddError("Error importing source", err.Error())
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, resource_source.SourceToModel(ctx, source, resp.Diagnostics))...)
+	model, diags := resource_source.SourceToModel(ctx, source)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(unsupportedConnectorWarning("source", model.Id.ValueString(), convert.CustomConnectorValue{}, model.Custom)...)
+
+	// Imported sources follow the provider default until configured otherwise
+	model.DeletionProtection = deletionProtection(r.client, model.DeletionProtection)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, r.client, model.Id)...)
 }
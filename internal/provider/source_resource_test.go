@@ -4,15 +4,22 @@
 package provider
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/resource_source"
+	"github.com/aws-gopher/unstructured-sdk-go"
 	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	frameworkresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestSourceResourceSchema(t *testing.T) {
@@ -39,7 +46,7 @@ func TestSourceResourceSchema(t *testing.T) {
 func TestAccSourceResource(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(t),
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
@@ -70,6 +77,30 @@ func TestAccSourceResource(t *testing.T) {
 	})
 }
 
+func TestAccSourceResourceImportIdentity(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(t),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceResourceConfig("Terraform Test Source Identity"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValueMatchesState("unstructured_source.test", tfjsonpath.New("id")),
+				},
+			},
+			// Import with an import block that sets the identity
+			{
+				ResourceName:    "unstructured_source.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
 func testAccSourceResourceConfig(name string) string {
 	return fmt.Sprintf(`
 resource "unstructured_source" "test" {
@@ -82,3 +113,204 @@ resource "unstructured_source" "test" {
 }
 `, name)
 }
+
+func testSourceModel(t *testing.T, id, name, remoteURL string) *resource_source.SourceModel {
+	t.Helper()
+
+	model := &resource_source.SourceModel{
+		Id:   types.StringNull(),
+		Name: types.StringValue(name),
+	}
+
+	if id != "" {
+		model.Id = types.StringValue(id)
+	}
+
+	if remoteURL != "" {
+		model.S3 = resource_source.NewS3ValueMust(resource_source.S3Value{}.AttributeTypes(t.Context()), map[string]attr.Value{
+			"remote_url":   types.StringValue(remoteURL),
+			"anonymous":    types.BoolValue(true),
+			"recursive":    types.BoolNull(),
+			"endpoint_url": types.StringNull(),
+			"key":          types.StringNull(),
+			"secret":       types.StringNull(),
+			"token":        types.StringNull(),
+		})
+	}
+
+	return model
+}
+
+func TestSourceResourceCRUD(t *testing.T) {
+	seed := func(c *fakeClient) {
+		c.sources["source-1"] = &unstructured.Source{
+			ID:     "source-1",
+			Name:   "existing",
+			Type:   unstructured.ConnectorTypeS3,
+			Config: &unstructured.S3SourceConnectorConfig{RemoteURL: "s3://existing/"},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		op      string
+		seed    func(*fakeClient)
+		err     error
+		prior   func(t *testing.T) *resource_source.SourceModel
+		planned func(t *testing.T) *resource_source.SourceModel
+		wantErr string
+		check   func(t *testing.T, c *fakeClient, state resource_source.SourceModel)
+	}{
+		{
+			name:    "create",
+			op:      "Create",
+			planned: func(t *testing.T) *resource_source.SourceModel { return testSourceModel(t, "", "new", "s3://bucket/") },
+			check: func(t *testing.T, c *fakeClient, state resource_source.SourceModel) {
+				config, ok := c.lastSourceConfig.(*unstructured.S3SourceConnectorConfigInput)
+				if !ok || config.RemoteURL != "s3://bucket/" || config.Anonymous == nil || !*config.Anonymous {
+					t.Errorf("CreateSource() config = %+v", c.lastSourceConfig)
+				}
+
+				if state.Id.ValueString() != "source-1" || state.Name.ValueString() != "new" {
+					t.Errorf("state = %s %s, want source-1 new", state.Id, state.Name)
+				}
+
+				if state.S3.RemoteUrl.ValueString() != "s3://bucket/" {
+					t.Errorf("state s3.remote_url = %s", state.S3.RemoteUrl)
+				}
+			},
+		},
+		{
+			name:    "create without a connector block",
+			op:      "Create",
+			planned: func(t *testing.T) *resource_source.SourceModel { return testSourceModel(t, "", "new", "") },
+			wantErr: "Error creating source configuration",
+		},
+		{
+			name:    "create API error",
+			op:      "Create",
+			err:     errors.New("boom"),
+			planned: func(t *testing.T) *resource_source.SourceModel { return testSourceModel(t, "", "new", "s3://bucket/") },
+			wantErr: "Error creating source",
+		},
+		{
+			name: "read",
+			op:   "Read",
+			seed: seed,
+			prior: func(t *testing.T) *resource_source.SourceModel {
+				return testSourceModel(t, "source-1", "stale", "s3://existing/")
+			},
+			check: func(t *testing.T, c *fakeClient, state resource_source.SourceModel) {
+				if state.Name.ValueString() != "existing" {
+					t.Errorf("state name = %s, want existing", state.Name)
+				}
+			},
+		},
+		{
+			name: "read missing",
+			op:   "Read",
+			prior: func(t *testing.T) *resource_source.SourceModel {
+				return testSourceModel(t, "source-1", "stale", "s3://existing/")
+			},
+			wantErr: "Error getting source",
+		},
+		{
+			name: "update",
+			op:   "Update",
+			seed: seed,
+			prior: func(t *testing.T) *resource_source.SourceModel {
+				return testSourceModel(t, "source-1", "existing", "s3://existing/")
+			},
+			planned: func(t *testing.T) *resource_source.SourceModel {
+				return testSourceModel(t, "source-1", "existing", "s3://updated/")
+			},
+			check: func(t *testing.T, c *fakeClient, state resource_source.SourceModel) {
+				if config, _ := c.sources["source-1"].Config.(*unstructured.S3SourceConnectorConfig); config == nil || config.RemoteURL != "s3://updated/" {
+					t.Errorf("source config after update = %+v", c.sources["source-1"].Config)
+				}
+
+				if state.Id.ValueString() != "source-1" || state.S3.RemoteUrl.ValueString() != "s3://updated/" {
+					t.Errorf("state = %s %s", state.Id, state.S3.RemoteUrl)
+				}
+			},
+		},
+		{
+			name: "update API error",
+			op:   "Update",
+			seed: seed,
+			err:  errors.New("boom"),
+			prior: func(t *testing.T) *resource_source.SourceModel {
+				return testSourceModel(t, "source-1", "existing", "s3://existing/")
+			},
+			planned: func(t *testing.T) *resource_source.SourceModel {
+				return testSourceModel(t, "source-1", "existing", "s3://updated/")
+			},
+			wantErr: "Error updating source",
+		},
+		{
+			name: "delete",
+			op:   "Delete",
+			seed: seed,
+			prior: func(t *testing.T) *resource_source.SourceModel {
+				return testSourceModel(t, "source-1", "existing", "s3://existing/")
+			},
+			check: func(t *testing.T, c *fakeClient, _ resource_source.SourceModel) {
+				if _, ok := c.sources["source-1"]; ok {
+					t.Error("source was not deleted")
+				}
+			},
+		},
+		{
+			name: "delete missing",
+			op:   "Delete",
+			prior: func(t *testing.T) *resource_source.SourceModel {
+				return testSourceModel(t, "source-1", "existing", "s3://existing/")
+			},
+			wantErr: "Error deleting source",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newFakeClient()
+			if tt.seed != nil {
+				tt.seed(c)
+			}
+			c.err = tt.err
+
+			r := NewSourceResource()
+			empty := testResource(t, r, c)
+
+			var prior, planned any
+			if tt.prior != nil {
+				prior = tt.prior(t)
+			}
+			if tt.planned != nil {
+				planned = tt.planned(t)
+			}
+
+			state, diags := testCRUD(t, r, empty, tt.op, prior, planned)
+			if tt.wantErr != "" {
+				if !diags.HasError() || !strings.Contains(fmt.Sprint(diags), tt.wantErr) {
+					t.Fatalf("%s() diagnostics = %v, want %q", tt.op, diags, tt.wantErr)
+				}
+				return
+			}
+
+			if diags.HasError() {
+				t.Fatalf("%s() diagnostics = %v", tt.op, diags)
+			}
+
+			var got resource_source.SourceModel
+			if !state.Raw.IsNull() {
+				if diags := state.Get(t.Context(), &got); diags.HasError() {
+					t.Fatalf("State.Get() diagnostics = %v", diags)
+				}
+			}
+
+			if tt.check != nil {
+				tt.check(t, c, got)
+			}
+		})
+	}
+}
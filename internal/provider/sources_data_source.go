@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/convert"
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/datasource_source"
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/resource_source"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*sourcesDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*sourcesDataSource)(nil)
+
+func NewSourcesDataSource() datasource.DataSource {
+	return &sourcesDataSource{}
+}
+
+type sourcesDataSource struct {
+	client client
+}
+
+type sourcesDataSourceModel struct {
+	Type      types.String `tfsdk:"type"`
+	NameRegex types.String `tfsdk:"name_regex"`
+	Sources   types.List   `tfsdk:"sources"`
+}
+
+func sourcesDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description: "Lists the sources visible to the API key.",
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list sources of this connector type, named as the API or as the connector block names it, such as `kafka-cloud` or `kafka_cloud`",
+				Validators: []validator.String{
+					stringvalidator.OneOf(connectorTypeFilters(resource_source.SourceConnectors, convert.SourceConnectorType)...),
+				},
+			},
+			"name_regex": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list sources whose name matches this regular expression",
+			},
+			"sources": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The matching sources, with the attributes of the `unstructured_source` data source",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: listElementAttributes(datasource_source.SourceDataSourceSchema(ctx)),
+				},
+			},
+		},
+	}
+}
+
+func (d *sourcesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sources"
+}
+
+func (d *sourcesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = sourcesDataSourceSchema(ctx)
+}
+
+func (d *sourcesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected an Unstructured API client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *sourcesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data sourcesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	re := nameRegex(data.NameRegex, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sources, unsupported, err := listSources(ctx, d.client, data.Type.ValueString(), re)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing sources", err.Error())
+		return
+	}
+
+	if len(unsupported) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Unsupported source types",
+			"These sources have connector types without a connector block of their own, so only their config_json is set: "+strings.Join(unsupported, ", "),
+		)
+	}
+
+	objs := make([]convert.Object, 0, len(sources))
+	for i := range sources {
+		obj, _ := convert.Source(&sources[i])
+		objs = append(objs, obj)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(convert.Project(ctx, convert.Object{
+		"type":       data.Type.ValueStringPointer(),
+		"name_regex": data.NameRegex.ValueStringPointer(),
+		"sources":    objs,
+	}, sourcesDataSourceSchema(ctx).Type(), &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Sources = emptyIfNull(ctx, data.Sources)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
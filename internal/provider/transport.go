@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+)
+
+// transportConfig holds the settings used to build the HTTP client shared by
+// all resources and data sources.
+type transportConfig struct {
+	ProxyURL           string
+	CACertPEM          string
+	CACertFile         string
+	ClientCertPEM      string
+	ClientKeyPEM       string
+	InsecureSkipVerify bool
+	UserAgent          string
+	Headers            map[string]string
+}
+
+// headerTransport sets the User-Agent and any additional headers on every
+// request before passing it on.
+type headerTransport struct {
+	userAgent string
+	headers   map[string]string
+	rt        http.RoundTripper
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// A RoundTripper must not modify the caller's request.
+	req = req.Clone(req.Context())
+
+	if t.userAgent != "" {
+		req.Header.Set("User-Agent", t.userAgent)
+	}
+
+	for k, v := range t.headers {
+		req.Header.Set(k, v)
+	}
+
+	return t.rt.RoundTrip(req)
+}
+
+// userAgent returns the User-Agent sent with every request, identifying the
+// provider and the Terraform CLI that runs it.
+func userAgent(providerVersion, terraformVersion string) string {
+	ua := fmt.Sprintf("terraform-provider-unstructured/%s (+https://registry.terraform.io/providers/aws-gopher/unstructured)", providerVersion)
+
+	if terraformVersion != "" {
+		ua = fmt.Sprintf("Terraform/%s %s", terraformVersion, ua)
+	}
+
+	return ua
+}
+
+// newHTTPClient builds an HTTP client from the given transport settings.
+// The returned client does not carry the API key; that is layered on top by
+// the SDK through unstructured.WithKey.
+func newHTTPClient(cfg transportConfig) (*http.Client, error) {
+	transport, ok := http.DefaultTransport.(*http.Transport)
+	if !ok {
+		return nil, errors.New("default HTTP transport is not an *http.Transport")
+	}
+
+	transport = transport.Clone()
+
+	if cfg.ProxyURL != "" {
+		proxy, err := url.Parse(cfg.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy_url: %w", err)
+		}
+
+		if proxy.Scheme == "" || proxy.Host == "" {
+			return nil, fmt.Errorf("invalid proxy_url %q: must be an absolute URL such as http://proxy.example.com:3128", cfg.ProxyURL)
+		}
+
+		transport.Proxy = http.ProxyURL(proxy)
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// The user explicitly opted out of verification, e.g. for a lab deployment.
+		InsecureSkipVerify: cfg.InsecureSkipVerify,
+	}
+
+	caPEM := []byte(cfg.CACertPEM)
+	if cfg.CACertFile != "" {
+		b, err := os.ReadFile(cfg.CACertFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read ca_cert_file: %w", err)
+		}
+
+		caPEM = b
+	}
+
+	if len(caPEM) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, errors.New("no valid PEM certificates found in the CA bundle")
+		}
+
+		tlsConfig.RootCAs = pool
+	}
+
+	if cfg.ClientCertPEM != "" || cfg.ClientKeyPEM != "" {
+		if cfg.ClientCertPEM == "" || cfg.ClientKeyPEM == "" {
+			return nil, errors.New("client_cert_pem and client_key_pem must be set together")
+		}
+
+		cert, err := tls.X509KeyPair([]byte(cfg.ClientCertPEM), []byte(cfg.ClientKeyPEM))
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate: %w", err)
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	transport.TLSClientConfig = tlsConfig
+
+	return &http.Client{
+		Transport: &headerTransport{
+			userAgent: cfg.UserAgent,
+			headers:   cfg.Headers,
+			rt:        transport,
+		},
+	}, nil
+}
@@ -0,0 +1,315 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"log"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws-gopher/unstructured-sdk-go"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testCA is a throwaway certificate authority used to issue server and client
+// certificates for TLS tests.
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  string
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Unstructured Test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &testCA{
+		cert: cert,
+		key:  key,
+		pem:  string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+	}
+}
+
+// issue returns a PEM-encoded certificate and key signed by the CA.
+func (ca *testCA) issue(t *testing.T, usage x509.ExtKeyUsage) (certPEM, keyPEM string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+}
+
+// newTestTLSServer starts a server with a certificate issued by ca. If
+// requireClientCert is set, the server only accepts clients presenting a
+// certificate issued by the same CA.
+func newTestTLSServer(t *testing.T, ca *testCA, requireClientCert bool) *httptest.Server {
+	t.Helper()
+
+	certPEM, keyPEM := ca.issue(t, x509.ExtKeyUsageServerAuth)
+
+	cert, err := tls.X509KeyPair([]byte(certPEM), []byte(keyPEM))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	srv.Config.ErrorLog = log.New(io.Discard, "", 0)
+	srv.TLS = &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{cert},
+	}
+
+	if requireClientCert {
+		pool := x509.NewCertPool()
+		pool.AddCert(ca.cert)
+
+		srv.TLS.ClientCAs = pool
+		srv.TLS.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func TestNewHTTPClient(t *testing.T) {
+	ca := newTestCA(t)
+	clientCert, clientKey := ca.issue(t, x509.ExtKeyUsageClientAuth)
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caFile, []byte(ca.pem), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name              string
+		cfg               transportConfig
+		requireClientCert bool
+		wantErr           bool
+	}{
+		{
+			name:    "untrusted CA",
+			cfg:     transportConfig{},
+			wantErr: true,
+		},
+		{
+			name: "CA from PEM",
+			cfg:  transportConfig{CACertPEM: ca.pem},
+		},
+		{
+			name: "CA from file",
+			cfg:  transportConfig{CACertFile: caFile},
+		},
+		{
+			name: "insecure skip verify",
+			cfg:  transportConfig{InsecureSkipVerify: true},
+		},
+		{
+			name:              "missing client certificate",
+			cfg:               transportConfig{CACertPEM: ca.pem},
+			requireClientCert: true,
+			wantErr:           true,
+		},
+		{
+			name: "client certificate",
+			cfg: transportConfig{
+				CACertPEM:     ca.pem,
+				ClientCertPEM: clientCert,
+				ClientKeyPEM:  clientKey,
+			},
+			requireClientCert: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newTestTLSServer(t, ca, tt.requireClientCert)
+
+			hc, err := newHTTPClient(tt.cfg)
+			if err != nil {
+				t.Fatalf("newHTTPClient() error = %v", err)
+			}
+
+			req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, srv.URL, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp, err := hc.Do(req)
+			if err == nil {
+				_ = resp.Body.Close()
+			}
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Do() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewHTTPClientProxy(t *testing.T) {
+	var proxied bool
+
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = true
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(proxy.Close)
+
+	hc, err := newHTTPClient(transportConfig{ProxyURL: proxy.URL})
+	if err != nil {
+		t.Fatalf("newHTTPClient() error = %v", err)
+	}
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "http://unstructured.invalid/api/v1/sources", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := hc.Do(req)
+	if err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	_ = resp.Body.Close()
+
+	if !proxied {
+		t.Error("expected request to be sent through the proxy")
+	}
+}
+
+func TestNewHTTPClientInvalid(t *testing.T) {
+	tests := map[string]transportConfig{
+		"relative proxy":   {ProxyURL: "proxy.example.com"},
+		"bad CA bundle":    {CACertPEM: "not a certificate"},
+		"missing CA file":  {CACertFile: filepath.Join(t.TempDir(), "missing.pem")},
+		"cert without key": {ClientCertPEM: "cert"},
+		"bad key pair":     {ClientCertPEM: "cert", ClientKeyPEM: "key"},
+	}
+
+	for name, cfg := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := newHTTPClient(cfg); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestConfigureUserAgentAndHeaders(t *testing.T) {
+	var got http.Header
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Clone()
+		_, _ = io.WriteString(w, "[]")
+	}))
+	t.Cleanup(srv.Close)
+
+	resp := testConfigureProvider(t, map[string]tftypes.Value{
+		"api_key":  tftypes.NewValue(tftypes.String, "test-key"),
+		"endpoint": tftypes.NewValue(tftypes.String, srv.URL+"/api/v1"),
+		"headers": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"X-Gateway-Route": tftypes.NewValue(tftypes.String, "unstructured"),
+		}),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Configure() diagnostics = %v", resp.Diagnostics)
+	}
+
+	if got == nil {
+		t.Fatal("expected Configure to validate credentials against the server")
+	}
+
+	if ua := got.Get("User-Agent"); !strings.Contains(ua, "terraform-provider-unstructured/test") || !strings.Contains(ua, "Terraform/1.12.0") {
+		t.Errorf("User-Agent = %q, want provider and Terraform versions", ua)
+	}
+
+	if v := got.Get("X-Gateway-Route"); v != "unstructured" {
+		t.Errorf("X-Gateway-Route = %q, want %q", v, "unstructured")
+	}
+
+	if v := got.Get(unstructured.HeaderKey); v != "test-key" {
+		t.Errorf("%s = %q, want %q", unstructured.HeaderKey, v, "test-key")
+	}
+}
+
+func TestConfigureHeadersRejectsAPIKey(t *testing.T) {
+	resp := testConfigureProvider(t, map[string]tftypes.Value{
+		"api_key":                     tftypes.NewValue(tftypes.String, "test-key"),
+		"skip_credentials_validation": tftypes.NewValue(tftypes.Bool, true),
+		"headers": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"unstructured-api-key": tftypes.NewValue(tftypes.String, "other-key"),
+		}),
+	})
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected an error for a headers entry overriding the API key")
+	}
+}
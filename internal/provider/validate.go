@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+
+	"github.com/aws-gopher/unstructured-sdk-go"
+)
+
+// validateEndpoint checks that endpoint is an absolute http or https URL.
+func validateEndpoint(endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return fmt.Errorf("endpoint %q is not a valid URL: %w", endpoint, err)
+	}
+
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return fmt.Errorf("endpoint %q must use the https or http scheme", endpoint)
+	}
+
+	if u.Host == "" {
+		return fmt.Errorf("endpoint %q is missing a host", endpoint)
+	}
+
+	return nil
+}
+
+// checkCredentials performs a lightweight authenticated request to confirm
+// that the endpoint is reachable and accepts the API key.
+func checkCredentials(ctx context.Context, client *unstructured.Client) error {
+	pageSize := 1
+
+	_, err := client.ListWorkflows(ctx, &unstructured.ListWorkflowsRequest{PageSize: &pageSize})
+
+	return err
+}
+
+// describeCredentialsError turns an error from checkCredentials into a
+// diagnostic summary and detail that name the kind of failure.
+func describeCredentialsError(endpoint string, err error) (string, string) {
+	var (
+		dnsErr      *net.DNSError
+		apiErr      *unstructured.APIError
+		verifyErr   *tls.CertificateVerificationError
+		unknownCA   x509.UnknownAuthorityError
+		hostnameErr x509.HostnameError
+		invalidErr  x509.CertificateInvalidError
+		recordErr   tls.RecordHeaderError
+		alertErr    tls.AlertError
+	)
+
+	switch {
+	case errors.As(err, &dnsErr):
+		return "Unstructured Endpoint DNS Lookup Failed",
+			fmt.Sprintf("The host %q of endpoint %s could not be resolved. "+
+				"Check the endpoint attribute or the UNSTRUCTURED_API_URL environment variable.\n\n%s", dnsErr.Name, endpoint, err)
+
+	case errors.As(err, &verifyErr), errors.As(err, &unknownCA), errors.As(err, &hostnameErr),
+		errors.As(err, &invalidErr), errors.As(err, &recordErr), errors.As(err, &alertErr):
+		return "Unstructured Endpoint TLS Handshake Failed",
+			fmt.Sprintf("A secure connection to %s could not be established. "+
+				"If the endpoint uses a private CA, set ca_cert_pem or ca_cert_file.\n\n%s", endpoint, err)
+
+	case errors.As(err, &apiErr) && apiErr.Code == http.StatusUnauthorized:
+		return "Unstructured API Key Rejected",
+			fmt.Sprintf("The endpoint %s returned 401 Unauthorized. "+
+				"The API key is missing, mistyped or has been revoked.\n\n%s", endpoint, err)
+
+	case errors.As(err, &apiErr) && apiErr.Code == http.StatusForbidden:
+		return "Unstructured API Key Forbidden",
+			fmt.Sprintf("The endpoint %s returned 403 Forbidden. "+
+				"The API key is valid but is not allowed to access this account.\n\n%s", endpoint, err)
+	}
+
+	return "Unable to Validate Unstructured Credentials",
+		fmt.Sprintf("The provider could not validate its credentials against %s. "+
+			"Set skip_credentials_validation to true to skip this check.\n\n%s", endpoint, err)
+}
@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/aws-gopher/unstructured-sdk-go"
+)
+
+func TestValidateEndpoint(t *testing.T) {
+	tests := map[string]bool{
+		"https://platform.unstructuredapp.io/api/v1": true,
+		"http://localhost:8080/api/v1":               true,
+		"platform.unstructuredapp.io/api/v1":         false,
+		"ftp://platform.unstructuredapp.io/api/v1":   false,
+		"https:///api/v1":                            false,
+		"https://%zz":                                false,
+	}
+
+	for endpoint, valid := range tests {
+		t.Run(endpoint, func(t *testing.T) {
+			if err := validateEndpoint(endpoint); (err == nil) != valid {
+				t.Errorf("validateEndpoint(%q) error = %v, want valid %v", endpoint, err, valid)
+			}
+		})
+	}
+}
+
+func TestCheckCredentials(t *testing.T) {
+	status := func(code int) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get(unstructured.HeaderKey) == "" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+
+			w.WriteHeader(code)
+			if code == http.StatusOK {
+				_, _ = io.WriteString(w, "[]")
+			}
+		}
+	}
+
+	tests := []struct {
+		name        string
+		server      func() *httptest.Server
+		endpoint    string
+		wantSummary string
+	}{
+		{
+			name:   "valid",
+			server: func() *httptest.Server { return httptest.NewServer(status(http.StatusOK)) },
+		},
+		{
+			name:        "unauthorized",
+			server:      func() *httptest.Server { return httptest.NewServer(status(http.StatusUnauthorized)) },
+			wantSummary: "Rejected",
+		},
+		{
+			name:        "forbidden",
+			server:      func() *httptest.Server { return httptest.NewServer(status(http.StatusForbidden)) },
+			wantSummary: "Forbidden",
+		},
+		{
+			name: "untrusted certificate",
+			server: func() *httptest.Server {
+				srv := httptest.NewUnstartedServer(status(http.StatusOK))
+				srv.Config.ErrorLog = log.New(io.Discard, "", 0)
+				srv.StartTLS()
+				return srv
+			},
+			wantSummary: "TLS",
+		},
+		{
+			name:        "unknown host",
+			endpoint:    "http://unstructured.invalid/api/v1",
+			wantSummary: "DNS",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			endpoint := tt.endpoint
+			if tt.server != nil {
+				srv := tt.server()
+				t.Cleanup(srv.Close)
+				endpoint = srv.URL + "/api/v1"
+			}
+
+			client, err := unstructured.New(
+				unstructured.WithClient(&http.Client{}),
+				unstructured.WithEndpoint(endpoint),
+				unstructured.WithKey("test-key"),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = checkCredentials(t.Context(), client)
+			if tt.wantSummary == "" {
+				if err != nil {
+					t.Fatalf("checkCredentials() error = %v", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+
+			summary, detail := describeCredentialsError(endpoint, err)
+			if !strings.Contains(summary, tt.wantSummary) {
+				t.Errorf("summary = %q, want it to mention %q", summary, tt.wantSummary)
+			}
+
+			if !strings.Contains(detail, endpoint) {
+				t.Errorf("detail = %q, want it to mention the endpoint", detail)
+			}
+		})
+	}
+}
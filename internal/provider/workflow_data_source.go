@@ -7,17 +7,19 @@ import (
 	"github.com/aws-gopher/terraform-provider-unstructured/internal/datasource_workflow"
 	"github.com/aws-gopher/unstructured-sdk-go"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 )
 
 var _ datasource.DataSource = (*workflowDataSource)(nil)
 var _ datasource.DataSourceWithConfigure = (*workflowDataSource)(nil)
+var _ datasource.DataSourceWithConfigValidators = (*workflowDataSource)(nil)
 
 func NewWorkflowDataSource() datasource.DataSource {
 	return &workflowDataSource{}
 }
 
 type workflowDataSource struct {
-	client *unstructured.Client
+	client client
 }
 
 func (d *workflowDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -33,16 +35,23 @@ func (d *workflowDataSource) Configure(ctx context.Context, req datasource.Confi
 		return
 	}
 
-	client, ok := req.ProviderData.(*unstructured.Client)
+	c, ok := req.ProviderData.(client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *unstructured.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected an Unstructured API client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = c
+}
+
+// ConfigValidators implements datasource.DataSourceWithConfigValidators.
+func (d *workflowDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		idOrName(),
+	}
 }
 
 func (d *workflowDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -55,13 +64,43 @@ func (d *workflowDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	// Read API call logic
-	workflow, err := d.client.GetWorkflow(ctx, data.Id.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Error getting workflow", err.Error())
+	// Get the workflow by ID, or find it by name
+	workflow := d.lookup(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Save data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, datasource_workflow.WorkflowToModel(ctx, workflow, resp.Diagnostics))...)
+	model, diags := datasource_workflow.WorkflowToModel(ctx, workflow)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+// lookup gets the workflow by ID, or finds it by name when no ID is set.
+func (d *workflowDataSource) lookup(ctx context.Context, data *datasource_workflow.WorkflowModel, diags *diag.Diagnostics) *unstructured.Workflow {
+	if !data.Id.IsNull() {
+		workflow, err := d.client.GetWorkflow(ctx, data.Id.ValueString())
+		if err != nil {
+			diags.AddError("Error getting workflow", err.Error())
+			return nil
+		}
+
+		return workflow
+	}
+
+	// Filter by name on the server, then keep only exact matches
+	name := data.Name.ValueString()
+	workflows, err := listAllWorkflows(ctx, d.client, unstructured.ListWorkflowsRequest{Name: &name})
+	if err != nil {
+		diags.AddError("Error listing workflows", err.Error())
+		return nil
+	}
+
+	return findByName("workflow", name, workflows, func(workflow *unstructured.Workflow) (string, string) {
+		return workflow.ID, workflow.Name
+	}, diags)
 }
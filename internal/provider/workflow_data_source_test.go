@@ -6,6 +6,7 @@ package provider
 import (
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-testing/compare"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
@@ -15,21 +16,23 @@ import (
 func TestAccWorkflowDataSource(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(t),
 		Steps: []resource.TestStep{
 			// Read testing
 			{
 				Config: testAccWorkflowDataSourceConfig,
 				ConfigStateChecks: []statecheck.StateCheck{
-					statecheck.ExpectKnownValue(
+					statecheck.CompareValuePairs(
 						"data.unstructured_workflow.test",
 						tfjsonpath.New("id"),
-						knownvalue.StringExact("f57569fe-36e4-4f3f-9ab3-447dc9419d2f"),
+						"unstructured_workflow.test",
+						tfjsonpath.New("id"),
+						compare.ValuesSame(),
 					),
 					statecheck.ExpectKnownValue(
 						"data.unstructured_workflow.test",
 						tfjsonpath.New("name"),
-						knownvalue.StringExact("Project Bronco"),
+						knownvalue.StringExact("Terraform Test Workflow Data Source"),
 					),
 				},
 			},
@@ -38,7 +41,12 @@ func TestAccWorkflowDataSource(t *testing.T) {
 }
 
 const testAccWorkflowDataSourceConfig = `
+resource "unstructured_workflow" "test" {
+  name          = "Terraform Test Workflow Data Source"
+  workflow_type = "basic"
+}
+
 data "unstructured_workflow" "test" {
-  id = "f57569fe-36e4-4f3f-9ab3-447dc9419d2f"
+  id = unstructured_workflow.test.id
 }
 `
@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/resource_workflow"
+	"github.com/aws-gopher/unstructured-sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ list.ListResource = (*workflowListResource)(nil)
+var _ list.ListResourceWithConfigure = (*workflowListResource)(nil)
+
+func NewWorkflowListResource() list.ListResource {
+	return &workflowListResource{}
+}
+
+type workflowListResource struct {
+	client client
+}
+
+type workflowListResourceModel struct {
+	Status        types.String `tfsdk:"status"`
+	SourceId      types.String `tfsdk:"source_id"`
+	DestinationId types.String `tfsdk:"destination_id"`
+	NameRegex     types.String `tfsdk:"name_regex"`
+}
+
+func (r *workflowListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow"
+}
+
+func (r *workflowListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the workflows visible to the API key.",
+		Attributes: map[string]schema.Attribute{
+			"status": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list workflows with this status, `active` or `inactive`",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(unstructured.WorkflowStateActive),
+						string(unstructured.WorkflowStateInactive),
+					),
+				},
+			},
+			"source_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list workflows that read from this source",
+			},
+			"destination_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list workflows that write to this destination",
+			},
+			"name_regex": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list workflows whose name matches this regular expression",
+			},
+		},
+	}
+}
+
+func (r *workflowListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if c := configureListResource(req, resp); c != nil {
+		r.client = c
+	}
+}
+
+func (r *workflowListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data workflowListResourceModel
+	var diags diag.Diagnostics
+
+	// Read the list configuration into the model
+	diags.Append(req.Config.Get(ctx, &data)...)
+
+	re := nameRegex(data.NameRegex, &diags)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	// The API filters by status, source and destination
+	in := unstructured.ListWorkflowsRequest{
+		SourceID:      data.SourceId.ValueStringPointer(),
+		DestinationID: data.DestinationId.ValueStringPointer(),
+	}
+
+	if !data.Status.IsNull() {
+		status := unstructured.WorkflowState(data.Status.ValueString())
+		in.Status = &status
+	}
+
+	all, err := listAllWorkflows(ctx, r.client, in)
+	if err != nil {
+		diags.AddError("Error listing workflows", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var workflows []unstructured.Workflow
+	for i := range all {
+		if matchName(re, all[i].Name) {
+			workflows = append(workflows, all[i])
+		}
+	}
+
+	stream.Results = listResults(ctx, r.client, req, diags, workflows, func(w *unstructured.Workflow) (string, string) {
+		return w.ID, w.Name
+	}, resource_workflow.WorkflowToModel)
+}
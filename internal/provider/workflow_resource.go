@@ -12,14 +12,16 @@ import (
 
 var _ resource.Resource = (*workflowResource)(nil)
 var _ resource.ResourceWithConfigure = (*workflowResource)(nil)
+var _ resource.ResourceWithIdentity = (*workflowResource)(nil)
 var _ resource.ResourceWithImportState = (*workflowResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*workflowResource)(nil)
 
 func NewWorkflowResource() resource.Resource {
 	return &workflowResource{}
 }
 
 type workflowResource struct {
-	client *unstructured.Client
+	client client
 }
 
 func (r *workflowResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -30,21 +32,38 @@ func (r *workflowResource) Schema(ctx context.Context, req resource.SchemaReques
 	resp.Schema = resource_workflow.WorkflowResourceSchema(ctx)
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity.
+func (r *workflowResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema("workflow")
+}
+
 func (r *workflowResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*unstructured.Client)
+	c, ok := req.ProviderData.(client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *unstructured.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected an Unstructured API client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = c
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+func (r *workflowResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planDeletionProtection(ctx, r.client, req, resp)
+}
+
+// carryOverUnstoredAttributes sets the attributes of model, as read from the
+// API, that the API does not store to their values in data, the planned or
+// prior state. Only the deletion protection setting is not stored.
+func (r *workflowResource) carryOverUnstoredAttributes(model, data *resource_workflow.WorkflowModel) {
+	model.DeletionProtection = data.DeletionProtection
 }
 
 func (r *workflowResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -138,14 +157,23 @@ func (r *workflowResource) Create(ctx context.Context, req resource.CreateReques
 		ReprocessAll:  reprocessAll,
 	}
 
-	workflow, err := r.client.CreateWorkflow(ctx, createRequest)
+	workflow, err := r.client.CreateWorkflow(ctx, &createRequest)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating workflow", err.Error())
 		return
 	}
 
 	// Convert the created workflow back to the model and set state
-	resp.Diagnostics.Append(resp.State.Set(ctx, resource_workflow.WorkflowToModel(ctx, workflow, resp.Diagnostics))...)
+	model, diags := resource_workflow.WorkflowToModel(ctx, workflow)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.carryOverUnstoredAttributes(model, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, r.client, model.Id)...)
 }
 
 func (r *workflowResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -166,7 +194,19 @@ func (r *workflowResource) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 
 	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, resource_workflow.WorkflowToModel(ctx, workflow, resp.Diagnostics))...)
+	model, diags := resource_workflow.WorkflowToModel(ctx, workflow)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.carryOverUnstoredAttributes(model, &data)
+
+	// State without the setting, as after an import, takes the provider default
+	model.DeletionProtection = deletionProtection(r.client, data.DeletionProtection)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	resp.Diagnostics.Append(keepIdentity(ctx, resp.Identity, r.client, model.Id)...)
 }
 
 func (r *workflowResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -283,7 +323,16 @@ func (r *workflowResource) Update(ctx context.Context, req resource.UpdateReques
 	}
 
 	// Convert the updated workflow back to the model and set state
-	resp.Diagnostics.Append(resp.State.Set(ctx, resource_workflow.WorkflowToModel(ctx, workflow, resp.Diagnostics))...)
+	model, diags := resource_workflow.WorkflowToModel(ctx, workflow)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.carryOverUnstoredAttributes(model, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	resp.Diagnostics.Append(keepIdentity(ctx, resp.Identity, r.client, model.Id)...)
 }
 
 func (r *workflowResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -296,6 +345,12 @@ func (r *workflowResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	// A protected workflow is kept until deletion_protection is turned off
+	resp.Diagnostics.Append(checkDeletionProtection("workflow", data.Id.ValueString(), data.DeletionProtection)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Delete API call logic
 	err := r.client.DeleteWorkflow(ctx, data.Id.ValueString())
 	if err != nil {
@@ -306,13 +361,29 @@ func (r *workflowResource) Delete(ctx context.Context, req resource.DeleteReques
 
 // ImportState implements resource.ResourceWithImportState.
 func (r *workflowResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import by ID
-	workflow, err := r.client.GetWorkflow(ctx, req.ID)
+	// Import by ID, or by the id of the import identity
+	id := importID(ctx, "workflow", r.client, req, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workflow, err := r.client.GetWorkflow(ctx, id)
 	if err != nil {
 		resp.Diagnostics.AddError("Error importing workflow", err.Error())
 		return
 	}
 
 	// Convert the workflow to the model and set state
-	resp.Diagnostics.Append(resp.State.Set(ctx, resource_workflow.WorkflowToModel(ctx, workflow, resp.Diagnostics))...)
+	model, diags := resource_workflow.WorkflowToModel(ctx, workflow)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Imported workflows follow the provider default until configured
+	// otherwise
+	model.DeletionProtection = deletionProtection(r.client, model.DeletionProtection)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, r.client, model.Id)...)
 }
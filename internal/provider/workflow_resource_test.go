@@ -4,10 +4,15 @@
 package provider
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/resource_workflow"
+	"github.com/aws-gopher/unstructured-sdk-go"
 	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
@@ -17,7 +22,7 @@ import (
 func TestAccWorkflowResource(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(t),
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
@@ -86,3 +91,198 @@ resource "unstructured_workflow" "test" {
 }
 `, configurableAttribute)
 }
+
+func testWorkflowModel(t *testing.T, id, name, sourceID string) *resource_workflow.WorkflowModel {
+	t.Helper()
+
+	model := &resource_workflow.WorkflowModel{
+		Id:            types.StringNull(),
+		Name:          types.StringValue(name),
+		SourceId:      types.StringNull(),
+		DestinationId: types.StringNull(),
+		Sources:       types.ListNull(types.StringType),
+		Destinations:  types.ListNull(types.StringType),
+		WorkflowNodes: types.ListNull(resource_workflow.WorkflowNodesValue{}.Type(t.Context())),
+		WorkflowType:  types.StringValue(string(unstructured.WorkflowTypeBasic)),
+		Schedule:      types.StringValue("0 0 * * *"),
+	}
+
+	if id != "" {
+		model.Id = types.StringValue(id)
+	}
+
+	if sourceID != "" {
+		model.SourceId = types.StringValue(sourceID)
+	}
+
+	return model
+}
+
+func TestWorkflowResourceCRUD(t *testing.T) {
+	seed := func(c *fakeClient) {
+		workflowType := unstructured.WorkflowTypeBasic
+		c.workflows["workflow-1"] = &unstructured.Workflow{
+			ID:           "workflow-1",
+			Name:         "existing",
+			Sources:      []string{"source-1"},
+			WorkflowType: &workflowType,
+			Status:       unstructured.WorkflowStateActive,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		op      string
+		seed    func(*fakeClient)
+		err     error
+		prior   func(t *testing.T) *resource_workflow.WorkflowModel
+		planned func(t *testing.T) *resource_workflow.WorkflowModel
+		wantErr string
+		check   func(t *testing.T, c *fakeClient, state resource_workflow.WorkflowModel)
+	}{
+		{
+			name: "create",
+			op:   "Create",
+			planned: func(t *testing.T) *resource_workflow.WorkflowModel {
+				return testWorkflowModel(t, "", "new", "source-1")
+			},
+			check: func(t *testing.T, c *fakeClient, state resource_workflow.WorkflowModel) {
+				req, ok := c.lastWorkflowRequest.(*unstructured.CreateWorkflowRequest)
+				if !ok || req.Name != "new" || req.SourceID == nil || *req.SourceID != "source-1" || req.Schedule == nil {
+					t.Errorf("CreateWorkflow() request = %+v", c.lastWorkflowRequest)
+				}
+
+				if state.Id.ValueString() != "workflow-1" || state.SourceId.ValueString() != "source-1" || state.Schedule.ValueString() != "0 0 * * *" {
+					t.Errorf("state = %s %s %s", state.Id, state.SourceId, state.Schedule)
+				}
+			},
+		},
+		{
+			name:    "create API error",
+			op:      "Create",
+			err:     errors.New("boom"),
+			planned: func(t *testing.T) *resource_workflow.WorkflowModel { return testWorkflowModel(t, "", "new", "") },
+			wantErr: "Error creating workflow",
+		},
+		{
+			name: "read",
+			op:   "Read",
+			seed: seed,
+			prior: func(t *testing.T) *resource_workflow.WorkflowModel {
+				return testWorkflowModel(t, "workflow-1", "stale", "")
+			},
+			check: func(t *testing.T, c *fakeClient, state resource_workflow.WorkflowModel) {
+				if state.Name.ValueString() != "existing" || state.SourceId.ValueString() != "source-1" || state.Status.ValueString() != "active" {
+					t.Errorf("state = %s %s %s", state.Name, state.SourceId, state.Status)
+				}
+			},
+		},
+		{
+			name: "read missing",
+			op:   "Read",
+			prior: func(t *testing.T) *resource_workflow.WorkflowModel {
+				return testWorkflowModel(t, "workflow-1", "stale", "")
+			},
+			wantErr: "Error getting workflow",
+		},
+		{
+			name: "update",
+			op:   "Update",
+			seed: seed,
+			prior: func(t *testing.T) *resource_workflow.WorkflowModel {
+				return testWorkflowModel(t, "workflow-1", "existing", "source-1")
+			},
+			planned: func(t *testing.T) *resource_workflow.WorkflowModel {
+				return testWorkflowModel(t, "workflow-1", "renamed", "source-1")
+			},
+			check: func(t *testing.T, c *fakeClient, state resource_workflow.WorkflowModel) {
+				req, ok := c.lastWorkflowRequest.(unstructured.UpdateWorkflowRequest)
+				if !ok || req.ID != "workflow-1" || req.Name == nil || *req.Name != "renamed" {
+					t.Errorf("UpdateWorkflow() request = %+v", c.lastWorkflowRequest)
+				}
+
+				if state.Name.ValueString() != "renamed" {
+					t.Errorf("state name = %s, want renamed", state.Name)
+				}
+			},
+		},
+		{
+			name: "update API error",
+			op:   "Update",
+			seed: seed,
+			err:  errors.New("boom"),
+			prior: func(t *testing.T) *resource_workflow.WorkflowModel {
+				return testWorkflowModel(t, "workflow-1", "existing", "")
+			},
+			planned: func(t *testing.T) *resource_workflow.WorkflowModel {
+				return testWorkflowModel(t, "workflow-1", "renamed", "")
+			},
+			wantErr: "Error updating workflow",
+		},
+		{
+			name: "delete",
+			op:   "Delete",
+			seed: seed,
+			prior: func(t *testing.T) *resource_workflow.WorkflowModel {
+				return testWorkflowModel(t, "workflow-1", "existing", "")
+			},
+			check: func(t *testing.T, c *fakeClient, _ resource_workflow.WorkflowModel) {
+				if _, ok := c.workflows["workflow-1"]; ok {
+					t.Error("workflow was not deleted")
+				}
+			},
+		},
+		{
+			name: "delete missing",
+			op:   "Delete",
+			prior: func(t *testing.T) *resource_workflow.WorkflowModel {
+				return testWorkflowModel(t, "workflow-1", "existing", "")
+			},
+			wantErr: "Error deleting workflow",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newFakeClient()
+			if tt.seed != nil {
+				tt.seed(c)
+			}
+			c.err = tt.err
+
+			r := NewWorkflowResource()
+			empty := testResource(t, r, c)
+
+			var prior, planned any
+			if tt.prior != nil {
+				prior = tt.prior(t)
+			}
+			if tt.planned != nil {
+				planned = tt.planned(t)
+			}
+
+			state, diags := testCRUD(t, r, empty, tt.op, prior, planned)
+			if tt.wantErr != "" {
+				if !diags.HasError() || !strings.Contains(fmt.Sprint(diags), tt.wantErr) {
+					t.Fatalf("%s() diagnostics = %v, want %q", tt.op, diags, tt.wantErr)
+				}
+				return
+			}
+
+			if diags.HasError() {
+				t.Fatalf("%s() diagnostics = %v", tt.op, diags)
+			}
+
+			var got resource_workflow.WorkflowModel
+			if !state.Raw.IsNull() {
+				if diags := state.Get(t.Context(), &got); diags.HasError() {
+					t.Fatalf("State.Get() diagnostics = %v", diags)
+				}
+			}
+
+			if tt.check != nil {
+				tt.check(t, c, got)
+			}
+		})
+	}
+}
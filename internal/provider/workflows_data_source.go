@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/convert"
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/datasource_workflow"
+	"github.com/aws-gopher/unstructured-sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*workflowsDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*workflowsDataSource)(nil)
+
+func NewWorkflowsDataSource() datasource.DataSource {
+	return &workflowsDataSource{}
+}
+
+type workflowsDataSource struct {
+	client client
+}
+
+type workflowsDataSourceModel struct {
+	Status        types.String `tfsdk:"status"`
+	SourceId      types.String `tfsdk:"source_id"`
+	DestinationId types.String `tfsdk:"destination_id"`
+	NameRegex     types.String `tfsdk:"name_regex"`
+	Workflows     types.List   `tfsdk:"workflows"`
+}
+
+func workflowsDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description: "Lists the workflows visible to the API key.",
+		Attributes: map[string]schema.Attribute{
+			"status": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list workflows with this status, `active` or `inactive`",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(unstructured.WorkflowStateActive),
+						string(unstructured.WorkflowStateInactive),
+					),
+				},
+			},
+			"source_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list workflows that read from this source",
+			},
+			"destination_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list workflows that write to this destination",
+			},
+			"name_regex": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list workflows whose name matches this regular expression",
+			},
+			"workflows": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The matching workflows, with the attributes of the `unstructured_workflow` data source",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: listElementAttributes(datasource_workflow.WorkflowDataSourceSchema(ctx)),
+				},
+			},
+		},
+	}
+}
+
+func (d *workflowsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflows"
+}
+
+func (d *workflowsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = workflowsDataSourceSchema(ctx)
+}
+
+func (d *workflowsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected an Unstructured API client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *workflowsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data workflowsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	re := nameRegex(data.NameRegex, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The API filters by status, source and destination
+	in := unstructured.ListWorkflowsRequest{
+		SourceID:      data.SourceId.ValueStringPointer(),
+		DestinationID: data.DestinationId.ValueStringPointer(),
+	}
+
+	if !data.Status.IsNull() {
+		status := unstructured.WorkflowState(data.Status.ValueString())
+		in.Status = &status
+	}
+
+	workflows, err := listAllWorkflows(ctx, d.client, in)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing workflows", err.Error())
+		return
+	}
+
+	objs := make([]convert.Object, 0, len(workflows))
+	for i := range workflows {
+		if matchName(re, workflows[i].Name) {
+			objs = append(objs, convert.Workflow(&workflows[i]))
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(convert.Project(ctx, convert.Object{
+		"status":         data.Status.ValueStringPointer(),
+		"source_id":      data.SourceId.ValueStringPointer(),
+		"destination_id": data.DestinationId.ValueStringPointer(),
+		"name_regex":     data.NameRegex.ValueStringPointer(),
+		"workflows":      objs,
+	}, workflowsDataSourceSchema(ctx).Type(), &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Workflows = emptyIfNull(ctx, data.Workflows)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
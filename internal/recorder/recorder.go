@@ -0,0 +1,361 @@
+// Package recorder provides an http.RoundTripper that records API
+// interactions to a cassette file and replays them later without network
+// access.
+//
+// Recorded requests and responses are scrubbed of credentials before they are
+// written, so cassettes can be committed alongside the tests that use them.
+package recorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/convert"
+)
+
+// Mode selects whether a Recorder talks to the real API or to its cassette.
+type Mode int
+
+const (
+	// ModeReplay serves responses from the cassette and never sends requests.
+	ModeReplay Mode = iota
+	// ModeRecord sends requests to the real API and records the interactions.
+	ModeRecord
+)
+
+// Redacted replaces scrubbed values in cassettes.
+const Redacted = "REDACTED"
+
+// Cassette is the on-disk format of a recording.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a single request and the response it received.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is a recorded request. The host is not recorded, so a cassette can
+// be replayed against any endpoint.
+type Request struct {
+	Method string          `json:"method"`
+	Path   string          `json:"path"`
+	Query  string          `json:"query,omitempty"`
+	Body   json.RawMessage `json:"body,omitempty"`
+}
+
+// Response is a recorded response.
+type Response struct {
+	StatusCode  int             `json:"status_code"`
+	ContentType string          `json:"content_type,omitempty"`
+	Body        json.RawMessage `json:"body,omitempty"`
+}
+
+// Recorder is an http.RoundTripper that records or replays interactions.
+type Recorder struct {
+	mode Mode
+	path string
+	next http.RoundTripper
+
+	mu       sync.Mutex
+	cassette Cassette
+	used     []bool
+}
+
+// New returns a Recorder for the cassette at path. In ModeReplay the cassette
+// must exist; in ModeRecord it is overwritten by Stop, and requests are sent
+// through next, or http.DefaultTransport if next is nil.
+func New(path string, mode Mode, next http.RoundTripper) (*Recorder, error) {
+	r := &Recorder{
+		mode: mode,
+		path: path,
+		next: next,
+	}
+
+	if r.next == nil {
+		r.next = http.DefaultTransport
+	}
+
+	if mode == ModeReplay {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read cassette: %w", err)
+		}
+
+		if err := json.Unmarshal(b, &r.cassette); err != nil {
+			return nil, fmt.Errorf("failed to parse cassette %s: %w", path, err)
+		}
+
+		r.used = make([]bool, len(r.cassette.Interactions))
+	}
+
+	return r, nil
+}
+
+// Mode returns the mode the Recorder was created with.
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// Wrap returns an http.RoundTripper that records through next in ModeRecord
+// and shares the Recorder's cassette. It lets the Recorder sit underneath a
+// client-specific transport, such as one configured with custom TLS
+// settings, and has the signature expected by transport hooks.
+func (r *Recorder) Wrap(next http.RoundTripper) http.RoundTripper {
+	return &transport{recorder: r, next: next}
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	return r.roundTrip(req, r.next)
+}
+
+// transport is a Recorder that sends requests through its own next.
+type transport struct {
+	recorder *Recorder
+	next     http.RoundTripper
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.recorder.roundTrip(req, t.next)
+}
+
+// roundTrip replays req, or sends it through next and records it.
+func (r *Recorder) roundTrip(req *http.Request, next http.RoundTripper) (*http.Response, error) {
+	recorded, err := newRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.mode == ModeReplay {
+		return r.replay(req, recorded)
+	}
+
+	resp, err := next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request: recorded,
+		Response: Response{
+			StatusCode:  resp.StatusCode,
+			ContentType: resp.Header.Get("Content-Type"),
+			Body:        scrub(body),
+		},
+	})
+
+	return resp, nil
+}
+
+// replay serves the first unused interaction matching the request. Once all
+// matching interactions are used, GET requests are answered with the last of
+// them, since the number of reads Terraform makes can vary between runs.
+func (r *Recorder) replay(req *http.Request, recorded Request) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	last := -1
+
+	for i, in := range r.cassette.Interactions {
+		if !in.Request.matches(recorded) {
+			continue
+		}
+
+		if !r.used[i] {
+			r.used[i] = true
+			return in.Response.toHTTP(req), nil
+		}
+
+		last = i
+	}
+
+	if last >= 0 && req.Method == http.MethodGet {
+		return r.cassette.Interactions[last].Response.toHTTP(req), nil
+	}
+
+	return nil, fmt.Errorf("recorder: no interaction in %s matches %s %s", r.path, req.Method, req.URL.RequestURI())
+}
+
+// Stop saves the cassette in ModeRecord. It is a no-op in ModeReplay.
+func (r *Recorder) Stop() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	b, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode cassette: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return fmt.Errorf("failed to create cassette directory: %w", err)
+	}
+
+	if err := os.WriteFile(r.path, append(b, '\n'), 0o644); err != nil {
+		return fmt.Errorf("failed to write cassette: %w", err)
+	}
+
+	return nil
+}
+
+// newRequest builds the scrubbed form of req used both for recording and for
+// matching, restoring the request body so it can still be sent.
+func newRequest(req *http.Request) (Request, error) {
+	recorded := Request{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Query:  req.URL.RawQuery,
+	}
+
+	if req.Body == nil || req.Body == http.NoBody {
+		return recorded, nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+
+	if err != nil {
+		return recorded, fmt.Errorf("failed to read request body: %w", err)
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	recorded.Body = scrub(body)
+
+	return recorded, nil
+}
+
+func (r Request) matches(other Request) bool {
+	return r.Method == other.Method &&
+		r.Path == other.Path &&
+		r.Query == other.Query &&
+		jsonEqual(r.Body, other.Body)
+}
+
+func (r Response) toHTTP(req *http.Request) *http.Response {
+	header := http.Header{}
+	if r.ContentType != "" {
+		header.Set("Content-Type", r.ContentType)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", r.StatusCode, http.StatusText(r.StatusCode)),
+		StatusCode:    r.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(r.Body)),
+		ContentLength: int64(len(r.Body)),
+		Request:       req,
+	}
+}
+
+// scrub returns body with the secret attributes of the connector configs in
+// it replaced. Bodies that are not JSON, such as multipart uploads, are not
+// recorded.
+func scrub(body []byte) json.RawMessage {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil
+	}
+
+	var v any
+	if err := json.Unmarshal(body, &v); err != nil {
+		return nil
+	}
+
+	b, err := json.Marshal(scrubValue(v))
+	if err != nil {
+		return nil
+	}
+
+	return b
+}
+
+// scrubValue scrubs the connector config objects in v. Fields outside them
+// are left alone, since names such as "key" are also used by workflows and
+// jobs.
+func scrubValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, val := range v {
+			if config, ok := val.(map[string]any); ok && k == "config" {
+				v[k] = scrubConfig(config)
+				continue
+			}
+
+			v[k] = scrubValue(val)
+		}
+
+	case []any:
+		for i, val := range v {
+			v[i] = scrubValue(val)
+		}
+	}
+
+	return v
+}
+
+// scrubConfig replaces the values of the secret attributes in a connector
+// config.
+func scrubConfig(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, val := range v {
+			if convert.SecretAttributes[k] && val != nil {
+				v[k] = Redacted
+				continue
+			}
+
+			v[k] = scrubConfig(val)
+		}
+
+	case []any:
+		for i, val := range v {
+			v[i] = scrubConfig(val)
+		}
+	}
+
+	return v
+}
+
+func jsonEqual(a, b json.RawMessage) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return len(a) == len(b)
+	}
+
+	var va, vb any
+	if errors.Join(json.Unmarshal(a, &va), json.Unmarshal(b, &vb)) != nil {
+		return bytes.Equal(a, b)
+	}
+
+	ca, _ := json.Marshal(va)
+	cb, _ := json.Marshal(vb)
+
+	return bytes.Equal(ca, cb)
+}
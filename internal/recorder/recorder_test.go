@@ -0,0 +1,231 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package recorder
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+const secret = "super-secret-value"
+
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method {
+		case http.MethodPost:
+			w.WriteHeader(http.StatusCreated)
+			_, _ = io.WriteString(w, `{"id":"1","config":{"remote_url":"s3://bucket/","secret":"`+secret+`"}}`)
+		default:
+			_, _ = io.WriteString(w, `{"id":"1","calls":`+strconv.Itoa(calls)+`}`)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func do(t *testing.T, rt http.RoundTripper, method, url, body string) (int, string) {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(t.Context(), method, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req.Header.Set("Unstructured-API-Key", secret)
+
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip(%s %s) error = %v", method, url, err)
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return resp.StatusCode, string(b)
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	srv := newTestServer(t)
+	path := filepath.Join(t.TempDir(), "cassettes", "test.json")
+
+	rec, err := New(path, ModeRecord, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	create := `{"name":"test","config":{"remote_url":"s3://bucket/","secret":"` + secret + `"}}`
+
+	code, _ := do(t, rec, http.MethodPost, srv.URL+"/api/v1/sources/", create)
+	if code != http.StatusCreated {
+		t.Fatalf("recorded POST status = %d", code)
+	}
+
+	_, first := do(t, rec, http.MethodGet, srv.URL+"/api/v1/sources/1", "")
+	_, second := do(t, rec, http.MethodGet, srv.URL+"/api/v1/sources/1", "")
+
+	if err := rec.Stop(); err != nil {
+		t.Fatalf("Stop() error = %v", err)
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Contains(string(b), secret) {
+		t.Errorf("cassette contains a secret:\n%s", b)
+	}
+
+	srv.Close()
+
+	replay, err := New(path, ModeReplay, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Field order and whitespace in the request body don't affect matching.
+	code, body := do(t, replay, http.MethodPost, "https://example.com/api/v1/sources/",
+		`{ "config": {"secret":"other-secret", "remote_url":"s3://bucket/"}, "name": "test" }`)
+	if code != http.StatusCreated || !strings.Contains(body, Redacted) {
+		t.Errorf("replayed POST = %d %s", code, body)
+	}
+
+	if _, got := do(t, replay, http.MethodGet, "https://example.com/api/v1/sources/1", ""); !jsonEqual([]byte(got), []byte(first)) {
+		t.Errorf("first replayed GET = %s, want %s", got, first)
+	}
+
+	if _, got := do(t, replay, http.MethodGet, "https://example.com/api/v1/sources/1", ""); !jsonEqual([]byte(got), []byte(second)) {
+		t.Errorf("second replayed GET = %s, want %s", got, second)
+	}
+
+	// Extra reads repeat the last recorded response.
+	if _, got := do(t, replay, http.MethodGet, "https://example.com/api/v1/sources/1", ""); !jsonEqual([]byte(got), []byte(second)) {
+		t.Errorf("extra replayed GET = %s, want %s", got, second)
+	}
+}
+
+// countingTransport counts the requests sent through it.
+type countingTransport struct {
+	calls int
+}
+
+func (c *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	c.calls++
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestWrap(t *testing.T) {
+	srv := newTestServer(t)
+	path := filepath.Join(t.TempDir(), "test.json")
+
+	rec, err := New(path, ModeRecord, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	first, second := &countingTransport{}, &countingTransport{}
+	a, b := rec.Wrap(first), rec.Wrap(second)
+
+	do(t, a, http.MethodGet, srv.URL+"/api/v1/sources/1", "")
+	do(t, b, http.MethodGet, srv.URL+"/api/v1/sources/1", "")
+	do(t, b, http.MethodGet, srv.URL+"/api/v1/sources/1", "")
+
+	if first.calls != 1 || second.calls != 2 {
+		t.Errorf("calls = %d, %d, want 1, 2", first.calls, second.calls)
+	}
+
+	if err := rec.Stop(); err != nil {
+		t.Fatal(err)
+	}
+
+	replay, err := New(path, ModeReplay, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := len(replay.cassette.Interactions); got != 3 {
+		t.Errorf("recorded %d interactions, want 3", got)
+	}
+}
+
+func TestReplayUnmatched(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.json")
+
+	rec, err := New(path, ModeRecord, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := rec.Stop(); err != nil {
+		t.Fatal(err)
+	}
+
+	replay, err := New(path, ModeReplay, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodDelete, "https://example.com/api/v1/sources/1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := replay.RoundTrip(req); err == nil {
+		t.Error("expected an error for a request that was not recorded")
+	}
+}
+
+func TestReplayMissingCassette(t *testing.T) {
+	if _, err := New(filepath.Join(t.TempDir(), "missing.json"), ModeReplay, nil); err == nil {
+		t.Error("expected an error for a missing cassette")
+	}
+}
+
+func TestScrub(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{
+			name: "connector",
+			body: `{"name":"n","config":{"api_key":"a","aws_secret_access_key":"b","token":null,"nested":[{"password":"c"}],"remote_url":"u","record_id_key":"id","next_token":"t","key":"k"}}`,
+			want: `{"config":{"api_key":"REDACTED","aws_secret_access_key":"REDACTED","key":"REDACTED","nested":[{"password":"REDACTED"}],"next_token":"t","record_id_key":"id","remote_url":"u","token":null},"name":"n"}`,
+		},
+		{
+			name: "list",
+			body: `[{"id":"1","config":{"password":"p"}}]`,
+			want: `[{"config":{"password":"REDACTED"},"id":"1"}]`,
+		},
+		{
+			name: "outside config",
+			body: `{"key":"k","token":"t","workflow_nodes":[{"settings":{"key":"k","password":"p"}}]}`,
+			want: `{"key":"k","token":"t","workflow_nodes":[{"settings":{"key":"k","password":"p"}}]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := string(scrub([]byte(tt.body))); got != tt.want {
+				t.Errorf("scrub() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,358 @@
+// Code generated by genconverters. DO NOT EDIT.
+
+package resource_destination
+
+import (
+	"context"
+
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/convert"
+	"github.com/aws-gopher/unstructured-sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// DestinationConnectors lists the connector blocks of DestinationModel.
+var DestinationConnectors = []string{
+	"astradb",
+	"azure_ai_search",
+	"couchbase",
+	"databricks_volumes",
+	"databricks_volume_delta_tables",
+	"delta_table",
+	"elasticsearch",
+	"gcs",
+	"kafka_cloud",
+	"milvus",
+	"mongodb",
+	"motherduck",
+	"neo4j",
+	"onedrive",
+	"pinecone",
+	"postgres",
+	"redis",
+	"qdrant_cloud",
+	"s3",
+	"snowflake",
+	"weaviate_cloud",
+	"ibm_watsonx_s3",
+}
+
+// DestinationConnectorBlocks returns the names of the connector blocks set in model.
+func DestinationConnectorBlocks(model *DestinationModel) []string {
+	var blocks []string
+	if !model.Astradb.IsNull() {
+		blocks = append(blocks, "astradb")
+	}
+	if !model.AzureAiSearch.IsNull() {
+		blocks = append(blocks, "azure_ai_search")
+	}
+	if !model.Couchbase.IsNull() {
+		blocks = append(blocks, "couchbase")
+	}
+	if !model.DatabricksVolumes.IsNull() {
+		blocks = append(blocks, "databricks_volumes")
+	}
+	if !model.DatabricksVolumeDeltaTables.IsNull() {
+		blocks = append(blocks, "databricks_volume_delta_tables")
+	}
+	if !model.DeltaTable.IsNull() {
+		blocks = append(blocks, "delta_table")
+	}
+	if !model.Elasticsearch.IsNull() {
+		blocks = append(blocks, "elasticsearch")
+	}
+	if !model.Gcs.IsNull() {
+		blocks = append(blocks, "gcs")
+	}
+	if !model.KafkaCloud.IsNull() {
+		blocks = append(blocks, "kafka_cloud")
+	}
+	if !model.Milvus.IsNull() {
+		blocks = append(blocks, "milvus")
+	}
+	if !model.Mongodb.IsNull() {
+		blocks = append(blocks, "mongodb")
+	}
+	if !model.Motherduck.IsNull() {
+		blocks = append(blocks, "motherduck")
+	}
+	if !model.Neo4j.IsNull() {
+		blocks = append(blocks, "neo4j")
+	}
+	if !model.Onedrive.IsNull() {
+		blocks = append(blocks, "onedrive")
+	}
+	if !model.Pinecone.IsNull() {
+		blocks = append(blocks, "pinecone")
+	}
+	if !model.Postgres.IsNull() {
+		blocks = append(blocks, "postgres")
+	}
+	if !model.Redis.IsNull() {
+		blocks = append(blocks, "redis")
+	}
+	if !model.QdrantCloud.IsNull() {
+		blocks = append(blocks, "qdrant_cloud")
+	}
+	if !model.S3.IsNull() {
+		blocks = append(blocks, "s3")
+	}
+	if !model.Snowflake.IsNull() {
+		blocks = append(blocks, "snowflake")
+	}
+	if !model.WeaviateCloud.IsNull() {
+		blocks = append(blocks, "weaviate_cloud")
+	}
+	if !model.IbmWatsonxS3.IsNull() {
+		blocks = append(blocks, "ibm_watsonx_s3")
+	}
+	return blocks
+}
+
+// DestinationConfigInput converts the first connector block set in model to an API
+// config input. It returns nil when no connector block is set.
+func DestinationConfigInput(ctx context.Context, model *DestinationModel) (unstructured.DestinationConfigInput, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	switch {
+	case !model.Astradb.IsNull():
+		config := &unstructured.AstraDBConnectorConfigInput{
+			CollectionName: model.Astradb.CollectionName.ValueString(),
+			Keyspace:       convert.StringPointer(model.Astradb.Keyspace),
+			BatchSize:      convert.IntPointer(model.Astradb.BatchSize),
+			APIEndpoint:    model.Astradb.ApiEndpoint.ValueString(),
+			Token:          model.Astradb.Token.ValueString(),
+		}
+		return config, diags
+	case !model.AzureAiSearch.IsNull():
+		config := &unstructured.AzureAISearchConnectorConfigInput{
+			Endpoint: model.AzureAiSearch.Endpoint.ValueString(),
+			Index:    model.AzureAiSearch.Index.ValueString(),
+			Key:      model.AzureAiSearch.Key.ValueString(),
+		}
+		return config, diags
+	case !model.Couchbase.IsNull():
+		config := &unstructured.CouchbaseDestinationConnectorConfigInput{
+			Bucket:           model.Couchbase.Bucket.ValueString(),
+			ConnectionString: model.Couchbase.ConnectionString.ValueString(),
+			Scope:            convert.StringPointer(model.Couchbase.Scope),
+			Collection:       convert.StringPointer(model.Couchbase.Collection),
+			BatchSize:        convert.Int(model.Couchbase.BatchSize),
+			Username:         model.Couchbase.Username.ValueString(),
+			Password:         model.Couchbase.Password.ValueString(),
+		}
+		return config, diags
+	case !model.DatabricksVolumes.IsNull():
+		config := &unstructured.DatabricksVolumesConnectorConfigInput{
+			Host:         model.DatabricksVolumes.Host.ValueString(),
+			Catalog:      model.DatabricksVolumes.Catalog.ValueString(),
+			Schema:       convert.StringPointer(model.DatabricksVolumes.Schema),
+			Volume:       model.DatabricksVolumes.Volume.ValueString(),
+			VolumePath:   model.DatabricksVolumes.VolumePath.ValueString(),
+			ClientSecret: model.DatabricksVolumes.ClientSecret.ValueString(),
+			ClientID:     model.DatabricksVolumes.ClientId.ValueString(),
+		}
+		return config, diags
+	case !model.DatabricksVolumeDeltaTables.IsNull():
+		config := &unstructured.DatabricksVDTDestinationConnectorConfigInput{
+			ServerHostname: model.DatabricksVolumeDeltaTables.ServerHostname.ValueString(),
+			HTTPPath:       model.DatabricksVolumeDeltaTables.HttpPath.ValueString(),
+			Token:          convert.StringPointer(model.DatabricksVolumeDeltaTables.Token),
+			ClientID:       convert.StringPointer(model.DatabricksVolumeDeltaTables.ClientId),
+			ClientSecret:   convert.StringPointer(model.DatabricksVolumeDeltaTables.ClientSecret),
+			Catalog:        model.DatabricksVolumeDeltaTables.Catalog.ValueString(),
+			Database:       convert.StringPointer(model.DatabricksVolumeDeltaTables.Database),
+			TableName:      convert.StringPointer(model.DatabricksVolumeDeltaTables.TableName),
+			Schema:         convert.StringPointer(model.DatabricksVolumeDeltaTables.Schema),
+			Volume:         model.DatabricksVolumeDeltaTables.Volume.ValueString(),
+			VolumePath:     convert.StringPointer(model.DatabricksVolumeDeltaTables.VolumePath),
+		}
+		return config, diags
+	case !model.DeltaTable.IsNull():
+		config := &unstructured.DeltaTableConnectorConfigInput{
+			AwsAccessKeyID:     model.DeltaTable.AwsAccessKeyId.ValueString(),
+			AwsSecretAccessKey: model.DeltaTable.AwsSecretAccessKey.ValueString(),
+			AwsRegion:          model.DeltaTable.AwsRegion.ValueString(),
+			TableURI:           model.DeltaTable.TableUri.ValueString(),
+		}
+		return config, diags
+	case !model.Elasticsearch.IsNull():
+		config := &unstructured.ElasticsearchConnectorConfigInput{
+			Hosts:     convert.Strings(ctx, model.Elasticsearch.Hosts, &diags),
+			IndexName: model.Elasticsearch.IndexName.ValueString(),
+			ESAPIKey:  model.Elasticsearch.EsApiKey.ValueString(),
+		}
+		return config, diags
+	case !model.Gcs.IsNull():
+		config := &unstructured.GCSDestinationConnectorConfigInput{
+			RemoteURL:         model.Gcs.RemoteUrl.ValueString(),
+			ServiceAccountKey: model.Gcs.ServiceAccountKey.ValueString(),
+		}
+		return config, diags
+	case !model.KafkaCloud.IsNull():
+		config := &unstructured.KafkaCloudDestinationConnectorConfigInput{
+			BootstrapServers: model.KafkaCloud.BootstrapServers.ValueString(),
+			Port:             convert.IntPointer(model.KafkaCloud.Port),
+			GroupID:          convert.StringPointer(model.KafkaCloud.GroupId),
+			Topic:            model.KafkaCloud.Topic.ValueString(),
+			KafkaAPIKey:      model.KafkaCloud.KafkaApiKey.ValueString(),
+			Secret:           model.KafkaCloud.Secret.ValueString(),
+			BatchSize:        convert.IntPointer(model.KafkaCloud.BatchSize),
+		}
+		return config, diags
+	case !model.Milvus.IsNull():
+		config := &unstructured.MilvusDestinationConnectorConfigInput{
+			URI:            model.Milvus.Uri.ValueString(),
+			User:           convert.StringPointer(model.Milvus.User),
+			Token:          convert.StringPointer(model.Milvus.Token),
+			Password:       convert.StringPointer(model.Milvus.Password),
+			DBName:         convert.StringPointer(model.Milvus.DbName),
+			CollectionName: model.Milvus.CollectionName.ValueString(),
+			RecordIDKey:    model.Milvus.RecordIdKey.ValueString(),
+		}
+		return config, diags
+	case !model.Mongodb.IsNull():
+		config := &unstructured.MongoDBConnectorConfigInput{
+			Database:   model.Mongodb.Database.ValueString(),
+			Collection: model.Mongodb.Collection.ValueString(),
+			URI:        model.Mongodb.Uri.ValueString(),
+		}
+		return config, diags
+	case !model.Motherduck.IsNull():
+		config := &unstructured.MotherduckDestinationConnectorConfigInput{
+			Account:     model.Motherduck.Account.ValueString(),
+			Role:        model.Motherduck.Role.ValueString(),
+			User:        model.Motherduck.User.ValueString(),
+			Password:    model.Motherduck.Password.ValueString(),
+			Host:        model.Motherduck.Host.ValueString(),
+			Port:        convert.IntPointer(model.Motherduck.Port),
+			Database:    model.Motherduck.Database.ValueString(),
+			Schema:      convert.StringPointer(model.Motherduck.Schema),
+			TableName:   convert.StringPointer(model.Motherduck.TableName),
+			BatchSize:   convert.IntPointer(model.Motherduck.BatchSize),
+			RecordIDKey: convert.StringPointer(model.Motherduck.RecordIdKey),
+		}
+		return config, diags
+	case !model.Neo4j.IsNull():
+		config := &unstructured.Neo4jDestinationConnectorConfigInput{
+			URI:       model.Neo4j.Uri.ValueString(),
+			Database:  model.Neo4j.Database.ValueString(),
+			Username:  model.Neo4j.Username.ValueString(),
+			Password:  model.Neo4j.Password.ValueString(),
+			BatchSize: convert.IntPointer(model.Neo4j.BatchSize),
+		}
+		return config, diags
+	case !model.Onedrive.IsNull():
+		config := &unstructured.OneDriveDestinationConnectorConfigInput{
+			ClientID:     model.Onedrive.ClientId.ValueString(),
+			UserPName:    model.Onedrive.UserPname.ValueString(),
+			Tenant:       model.Onedrive.Tenant.ValueString(),
+			AuthorityURL: model.Onedrive.AuthorityUrl.ValueString(),
+			ClientCred:   model.Onedrive.ClientCred.ValueString(),
+		}
+		return config, diags
+	case !model.Pinecone.IsNull():
+		config := &unstructured.PineconeDestinationConnectorConfigInput{
+			IndexName: model.Pinecone.IndexName.ValueString(),
+			APIKey:    model.Pinecone.ApiKey.ValueString(),
+			Namespace: model.Pinecone.Namespace.ValueString(),
+			BatchSize: convert.IntPointer(model.Pinecone.BatchSize),
+		}
+		return config, diags
+	case !model.Postgres.IsNull():
+		config := &unstructured.PostgresDestinationConnectorConfigInput{
+			Host:      model.Postgres.Host.ValueString(),
+			Database:  model.Postgres.Database.ValueString(),
+			Port:      convert.Int(model.Postgres.Port),
+			Username:  model.Postgres.Username.ValueString(),
+			Password:  model.Postgres.Password.ValueString(),
+			TableName: model.Postgres.TableName.ValueString(),
+			BatchSize: convert.Int(model.Postgres.BatchSize),
+		}
+		return config, diags
+	case !model.Redis.IsNull():
+		config := &unstructured.RedisDestinationConnectorConfigInput{
+			Host:      model.Redis.Host.ValueString(),
+			Port:      convert.IntPointer(model.Redis.Port),
+			Username:  convert.StringPointer(model.Redis.Username),
+			Password:  convert.StringPointer(model.Redis.Password),
+			URI:       convert.StringPointer(model.Redis.Uri),
+			Database:  convert.IntPointer(model.Redis.Database),
+			SSL:       convert.BoolPointer(model.Redis.Ssl),
+			BatchSize: convert.IntPointer(model.Redis.BatchSize),
+		}
+		return config, diags
+	case !model.QdrantCloud.IsNull():
+		config := &unstructured.QdrantCloudDestinationConnectorConfigInput{
+			URL:            model.QdrantCloud.Url.ValueString(),
+			APIKey:         model.QdrantCloud.ApiKey.ValueString(),
+			CollectionName: model.QdrantCloud.CollectionName.ValueString(),
+			BatchSize:      convert.IntPointer(model.QdrantCloud.BatchSize),
+		}
+		return config, diags
+	case !model.S3.IsNull():
+		config := &unstructured.S3DestinationConnectorConfigInput{
+			RemoteURL:   model.S3.RemoteUrl.ValueString(),
+			Anonymous:   convert.BoolPointer(model.S3.Anonymous),
+			Key:         convert.StringPointer(model.S3.Key),
+			Secret:      convert.StringPointer(model.S3.Secret),
+			Token:       convert.StringPointer(model.S3.Token),
+			EndpointURL: convert.StringPointer(model.S3.EndpointUrl),
+		}
+		return config, diags
+	case !model.Snowflake.IsNull():
+		config := &unstructured.SnowflakeDestinationConnectorConfigInput{
+			Account:     model.Snowflake.Account.ValueString(),
+			Role:        model.Snowflake.Role.ValueString(),
+			User:        model.Snowflake.User.ValueString(),
+			Password:    model.Snowflake.Password.ValueString(),
+			Host:        model.Snowflake.Host.ValueString(),
+			Port:        convert.IntPointer(model.Snowflake.Port),
+			Database:    model.Snowflake.Database.ValueString(),
+			Schema:      convert.StringPointer(model.Snowflake.Schema),
+			TableName:   convert.StringPointer(model.Snowflake.TableName),
+			BatchSize:   convert.IntPointer(model.Snowflake.BatchSize),
+			RecordIDKey: convert.StringPointer(model.Snowflake.RecordIdKey),
+		}
+		return config, diags
+	case !model.WeaviateCloud.IsNull():
+		config := &unstructured.WeaviateDestinationConnectorConfigInput{
+			ClusterURL: model.WeaviateCloud.ClusterUrl.ValueString(),
+			APIKey:     model.WeaviateCloud.ApiKey.ValueString(),
+			Collection: convert.StringPointer(model.WeaviateCloud.Collection),
+		}
+		return config, diags
+	case !model.IbmWatsonxS3.IsNull():
+		config := &unstructured.IBMWatsonxS3DestinationConnectorConfigInput{
+			IAMApiKey:             model.IbmWatsonxS3.IamApiKey.ValueString(),
+			AccessKeyID:           model.IbmWatsonxS3.AccessKeyId.ValueString(),
+			SecretAccessKey:       model.IbmWatsonxS3.SecretAccessKey.ValueString(),
+			IcebergEndpoint:       model.IbmWatsonxS3.IcebergEndpoint.ValueString(),
+			ObjectStorageEndpoint: model.IbmWatsonxS3.ObjectStorageEndpoint.ValueString(),
+			ObjectStorageRegion:   model.IbmWatsonxS3.ObjectStorageRegion.ValueString(),
+			Catalog:               model.IbmWatsonxS3.Catalog.ValueString(),
+			MaxRetriesConnection:  convert.IntPointer(model.IbmWatsonxS3.MaxRetriesConnection),
+			Namespace:             model.IbmWatsonxS3.Namespace.ValueString(),
+			Table:                 model.IbmWatsonxS3.Table.ValueString(),
+			MaxRetries:            convert.IntPointer(model.IbmWatsonxS3.MaxRetries),
+			RecordIDKey:           convert.StringPointer(model.IbmWatsonxS3.RecordIdKey),
+		}
+		return config, diags
+	}
+
+	return nil, diags
+}
+
+// KeepDestinationUnstoredAttributes sets the connector attributes of model that the API
+// does not return to their values in prior, when both set the same block.
+func KeepDestinationUnstoredAttributes(model, prior *DestinationModel) {
+	if !model.Couchbase.IsNull() && !model.Couchbase.IsUnknown() && !prior.Couchbase.IsNull() && !prior.Couchbase.IsUnknown() {
+		model.Couchbase.CollectionId = prior.Couchbase.CollectionId
+	}
+	if !model.Onedrive.IsNull() && !model.Onedrive.IsUnknown() && !prior.Onedrive.IsNull() && !prior.Onedrive.IsUnknown() {
+		model.Onedrive.Recursive = prior.Onedrive.Recursive
+		model.Onedrive.Path = prior.Onedrive.Path
+	}
+	if !model.S3.IsNull() && !model.S3.IsUnknown() && !prior.S3.IsNull() && !prior.S3.IsUnknown() {
+		model.S3.Recursive = prior.S3.Recursive
+	}
+}
@@ -0,0 +1,327 @@
+// Code generated by genconverters. DO NOT EDIT.
+
+package resource_source
+
+import (
+	"context"
+
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/convert"
+	"github.com/aws-gopher/unstructured-sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// SourceConnectors lists the connector blocks of SourceModel.
+var SourceConnectors = []string{
+	"s3",
+	"postgres",
+	"azure",
+	"box",
+	"confluence",
+	"couchbase",
+	"databricks_volumes",
+	"dropbox",
+	"elasticsearch",
+	"gcs",
+	"google_drive",
+	"jira",
+	"kafka_cloud",
+	"mongodb",
+	"onedrive",
+	"outlook",
+	"salesforce",
+	"sharepoint",
+	"snowflake",
+	"zendesk",
+}
+
+// SourceConnectorBlocks returns the names of the connector blocks set in model.
+func SourceConnectorBlocks(model *SourceModel) []string {
+	var blocks []string
+	if !model.S3.IsNull() {
+		blocks = append(blocks, "s3")
+	}
+	if !model.Postgres.IsNull() {
+		blocks = append(blocks, "postgres")
+	}
+	if !model.Azure.IsNull() {
+		blocks = append(blocks, "azure")
+	}
+	if !model.Box.IsNull() {
+		blocks = append(blocks, "box")
+	}
+	if !model.Confluence.IsNull() {
+		blocks = append(blocks, "confluence")
+	}
+	if !model.Couchbase.IsNull() {
+		blocks = append(blocks, "couchbase")
+	}
+	if !model.DatabricksVolumes.IsNull() {
+		blocks = append(blocks, "databricks_volumes")
+	}
+	if !model.Dropbox.IsNull() {
+		blocks = append(blocks, "dropbox")
+	}
+	if !model.Elasticsearch.IsNull() {
+		blocks = append(blocks, "elasticsearch")
+	}
+	if !model.Gcs.IsNull() {
+		blocks = append(blocks, "gcs")
+	}
+	if !model.GoogleDrive.IsNull() {
+		blocks = append(blocks, "google_drive")
+	}
+	if !model.Jira.IsNull() {
+		blocks = append(blocks, "jira")
+	}
+	if !model.KafkaCloud.IsNull() {
+		blocks = append(blocks, "kafka_cloud")
+	}
+	if !model.Mongodb.IsNull() {
+		blocks = append(blocks, "mongodb")
+	}
+	if !model.Onedrive.IsNull() {
+		blocks = append(blocks, "onedrive")
+	}
+	if !model.Outlook.IsNull() {
+		blocks = append(blocks, "outlook")
+	}
+	if !model.Salesforce.IsNull() {
+		blocks = append(blocks, "salesforce")
+	}
+	if !model.Sharepoint.IsNull() {
+		blocks = append(blocks, "sharepoint")
+	}
+	if !model.Snowflake.IsNull() {
+		blocks = append(blocks, "snowflake")
+	}
+	if !model.Zendesk.IsNull() {
+		blocks = append(blocks, "zendesk")
+	}
+	return blocks
+}
+
+// SourceConfigInput converts the first connector block set in model to an API
+// config input. It returns nil when no connector block is set.
+func SourceConfigInput(ctx context.Context, model *SourceModel) (unstructured.SourceConfigInput, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	switch {
+	case !model.S3.IsNull():
+		config := &unstructured.S3SourceConnectorConfigInput{
+			RemoteURL:   model.S3.RemoteUrl.ValueString(),
+			Anonymous:   convert.BoolPointer(model.S3.Anonymous),
+			Key:         convert.StringPointer(model.S3.Key),
+			Secret:      convert.StringPointer(model.S3.Secret),
+			Token:       convert.StringPointer(model.S3.Token),
+			EndpointURL: convert.StringPointer(model.S3.EndpointUrl),
+			Recursive:   convert.BoolPointer(model.S3.Recursive),
+		}
+		return config, diags
+	case !model.Postgres.IsNull():
+		config := &unstructured.PostgresSourceConnectorConfigInput{
+			Host:      model.Postgres.Host.ValueString(),
+			Database:  model.Postgres.Database.ValueString(),
+			Port:      convert.Int(model.Postgres.Port),
+			Username:  model.Postgres.Username.ValueString(),
+			Password:  model.Postgres.Password.ValueString(),
+			TableName: model.Postgres.TableName.ValueString(),
+			BatchSize: convert.Int(model.Postgres.BatchSize),
+			IDColumn:  convert.StringPointer(model.Postgres.IdColumn),
+			Fields:    convert.Strings(ctx, model.Postgres.Fields, &diags),
+		}
+		return config, diags
+	case !model.Azure.IsNull():
+		config := &unstructured.AzureSourceConnectorConfigInput{
+			RemoteURL:        model.Azure.RemoteUrl.ValueString(),
+			AccountName:      convert.StringPointer(model.Azure.AccountName),
+			AccountKey:       convert.StringPointer(model.Azure.AccountKey),
+			ConnectionString: convert.StringPointer(model.Azure.ConnectionString),
+			SASToken:         convert.StringPointer(model.Azure.SasToken),
+			Recursive:        convert.BoolPointer(model.Azure.Recursive),
+		}
+		return config, diags
+	case !model.Box.IsNull():
+		config := &unstructured.BoxSourceConnectorConfigInput{
+			BoxAppConfig: model.Box.BoxAppConfig.ValueString(),
+			RemoteURL:    model.Box.RemoteUrl.ValueString(),
+			Recursive:    convert.BoolPointer(model.Box.Recursive),
+		}
+		return config, diags
+	case !model.Confluence.IsNull():
+		config := &unstructured.ConfluenceSourceConnectorConfigInput{
+			URL:                       model.Confluence.Url.ValueString(),
+			Username:                  model.Confluence.Username.ValueString(),
+			Password:                  convert.StringPointer(model.Confluence.Password),
+			APIToken:                  convert.StringPointer(model.Confluence.ApiToken),
+			Token:                     convert.StringPointer(model.Confluence.Token),
+			Cloud:                     convert.BoolPointer(model.Confluence.Cloud),
+			ExtractImages:             convert.BoolPointer(model.Confluence.ExtractImages),
+			ExtractFiles:              convert.BoolPointer(model.Confluence.ExtractFiles),
+			MaxNumOfSpaces:            convert.IntPointer(model.Confluence.MaxNumOfSpaces),
+			MaxNumOfDocsFromEachSpace: convert.IntPointer(model.Confluence.MaxNumOfDocsFromEachSpace),
+			Spaces:                    convert.Strings(ctx, model.Confluence.Spaces, &diags),
+		}
+		return config, diags
+	case !model.Couchbase.IsNull():
+		config := &unstructured.CouchbaseSourceConnectorConfigInput{
+			Bucket:           model.Couchbase.Bucket.ValueString(),
+			ConnectionString: model.Couchbase.ConnectionString.ValueString(),
+			Scope:            convert.StringPointer(model.Couchbase.Scope),
+			Collection:       convert.StringPointer(model.Couchbase.Collection),
+			BatchSize:        convert.Int(model.Couchbase.BatchSize),
+			Username:         model.Couchbase.Username.ValueString(),
+			Password:         model.Couchbase.Password.ValueString(),
+			CollectionID:     model.Couchbase.CollectionId.ValueString(),
+		}
+		return config, diags
+	case !model.DatabricksVolumes.IsNull():
+		config := &unstructured.DatabricksVolumesConnectorConfigInput{
+			Host:         model.DatabricksVolumes.Host.ValueString(),
+			Catalog:      model.DatabricksVolumes.Catalog.ValueString(),
+			Schema:       convert.StringPointer(model.DatabricksVolumes.Schema),
+			Volume:       model.DatabricksVolumes.Volume.ValueString(),
+			VolumePath:   model.DatabricksVolumes.VolumePath.ValueString(),
+			ClientSecret: model.DatabricksVolumes.ClientSecret.ValueString(),
+			ClientID:     model.DatabricksVolumes.ClientId.ValueString(),
+		}
+		return config, diags
+	case !model.Dropbox.IsNull():
+		config := &unstructured.DropboxSourceConnectorConfigInput{
+			Token:     model.Dropbox.Token.ValueString(),
+			RemoteURL: model.Dropbox.RemoteUrl.ValueString(),
+			Recursive: convert.BoolPointer(model.Dropbox.Recursive),
+		}
+		return config, diags
+	case !model.Elasticsearch.IsNull():
+		config := &unstructured.ElasticsearchConnectorConfigInput{
+			Hosts:     convert.Strings(ctx, model.Elasticsearch.Hosts, &diags),
+			IndexName: model.Elasticsearch.IndexName.ValueString(),
+			ESAPIKey:  model.Elasticsearch.EsApiKey.ValueString(),
+		}
+		return config, diags
+	case !model.Gcs.IsNull():
+		config := &unstructured.GCSSourceConnectorConfigInput{
+			RemoteURL:         model.Gcs.RemoteUrl.ValueString(),
+			ServiceAccountKey: model.Gcs.ServiceAccountKey.ValueString(),
+			Recursive:         convert.BoolPointer(model.Gcs.Recursive),
+		}
+		return config, diags
+	case !model.GoogleDrive.IsNull():
+		config := &unstructured.GoogleDriveSourceConnectorConfigInput{
+			DriveID:           model.GoogleDrive.DriveId.ValueString(),
+			ServiceAccountKey: convert.StringPointer(model.GoogleDrive.ServiceAccountKey),
+			Extensions:        convert.Strings(ctx, model.GoogleDrive.Extensions, &diags),
+			Recursive:         convert.BoolPointer(model.GoogleDrive.Recursive),
+		}
+		return config, diags
+	case !model.Jira.IsNull():
+		config := &unstructured.JiraSourceConnectorConfigInput{
+			URL:                 model.Jira.Url.ValueString(),
+			Username:            model.Jira.Username.ValueString(),
+			Password:            convert.StringPointer(model.Jira.Password),
+			Token:               convert.StringPointer(model.Jira.Token),
+			Cloud:               convert.BoolPointer(model.Jira.Cloud),
+			Projects:            convert.Strings(ctx, model.Jira.Projects, &diags),
+			Boards:              convert.Strings(ctx, model.Jira.Boards, &diags),
+			Issues:              convert.Strings(ctx, model.Jira.Issues, &diags),
+			StatusFilters:       convert.Strings(ctx, model.Jira.StatusFilters, &diags),
+			DownloadAttachments: convert.BoolPointer(model.Jira.DownloadAttachments),
+		}
+		return config, diags
+	case !model.KafkaCloud.IsNull():
+		config := &unstructured.KafkaCloudSourceConnectorConfigInput{
+			BootstrapServers:     model.KafkaCloud.BootstrapServers.ValueString(),
+			Port:                 convert.IntPointer(model.KafkaCloud.Port),
+			GroupID:              convert.StringPointer(model.KafkaCloud.GroupId),
+			Topic:                model.KafkaCloud.Topic.ValueString(),
+			KafkaAPIKey:          model.KafkaCloud.KafkaApiKey.ValueString(),
+			Secret:               model.KafkaCloud.Secret.ValueString(),
+			NumMessagesToConsume: convert.IntPointer(model.KafkaCloud.NumMessagesToConsume),
+		}
+		return config, diags
+	case !model.Mongodb.IsNull():
+		config := &unstructured.MongoDBConnectorConfigInput{
+			Database:   model.Mongodb.Database.ValueString(),
+			Collection: model.Mongodb.Collection.ValueString(),
+			URI:        model.Mongodb.Uri.ValueString(),
+		}
+		return config, diags
+	case !model.Onedrive.IsNull():
+		config := &unstructured.OneDriveSourceConnectorConfigInput{
+			ClientID:     model.Onedrive.ClientId.ValueString(),
+			UserPName:    model.Onedrive.UserPname.ValueString(),
+			Tenant:       model.Onedrive.Tenant.ValueString(),
+			AuthorityURL: model.Onedrive.AuthorityUrl.ValueString(),
+			ClientCred:   model.Onedrive.ClientCred.ValueString(),
+			Recursive:    convert.BoolPointer(model.Onedrive.Recursive),
+			Path:         model.Onedrive.Path.ValueString(),
+		}
+		return config, diags
+	case !model.Outlook.IsNull():
+		config := &unstructured.OutlookSourceConnectorConfigInput{
+			AuthorityURL:   convert.StringPointer(model.Outlook.AuthorityUrl),
+			Tenant:         convert.StringPointer(model.Outlook.Tenant),
+			ClientID:       model.Outlook.ClientId.ValueString(),
+			ClientCred:     model.Outlook.ClientCred.ValueString(),
+			OutlookFolders: convert.Strings(ctx, model.Outlook.OutlookFolders, &diags),
+			Recursive:      convert.BoolPointer(model.Outlook.Recursive),
+			UserEmail:      model.Outlook.UserEmail.ValueString(),
+		}
+		return config, diags
+	case !model.Salesforce.IsNull():
+		config := &unstructured.SalesforceSourceConnectorConfigInput{
+			Username:    model.Salesforce.Username.ValueString(),
+			ConsumerKey: model.Salesforce.ConsumerKey.ValueString(),
+			PrivateKey:  model.Salesforce.PrivateKey.ValueString(),
+			Categories:  convert.Strings(ctx, model.Salesforce.Categories, &diags),
+		}
+		return config, diags
+	case !model.Sharepoint.IsNull():
+		config := &unstructured.SharePointSourceConnectorConfigInput{
+			Site:         model.Sharepoint.Site.ValueString(),
+			Tenant:       model.Sharepoint.Tenant.ValueString(),
+			AuthorityURL: convert.StringPointer(model.Sharepoint.AuthorityUrl),
+			UserPName:    model.Sharepoint.UserPname.ValueString(),
+			ClientID:     model.Sharepoint.ClientId.ValueString(),
+			ClientCred:   model.Sharepoint.ClientCred.ValueString(),
+			Recursive:    convert.BoolPointer(model.Sharepoint.Recursive),
+			Path:         convert.StringPointer(model.Sharepoint.Path),
+		}
+		return config, diags
+	case !model.Snowflake.IsNull():
+		config := &unstructured.SnowflakeSourceConnectorConfigInput{
+			Account:   model.Snowflake.Account.ValueString(),
+			Role:      model.Snowflake.Role.ValueString(),
+			User:      model.Snowflake.User.ValueString(),
+			Password:  model.Snowflake.Password.ValueString(),
+			Host:      model.Snowflake.Host.ValueString(),
+			Port:      convert.IntPointer(model.Snowflake.Port),
+			Database:  model.Snowflake.Database.ValueString(),
+			Schema:    convert.StringPointer(model.Snowflake.Schema),
+			TableName: convert.StringPointer(model.Snowflake.TableName),
+			BatchSize: convert.IntPointer(model.Snowflake.BatchSize),
+			IDColumn:  convert.StringPointer(model.Snowflake.IdColumn),
+			Fields:    convert.Strings(ctx, model.Snowflake.Fields, &diags),
+		}
+		return config, diags
+	case !model.Zendesk.IsNull():
+		config := &unstructured.ZendeskSourceConnectorConfigInput{
+			Subdomain: model.Zendesk.Subdomain.ValueString(),
+			Email:     model.Zendesk.Email.ValueString(),
+			APIToken:  model.Zendesk.ApiToken.ValueString(),
+			ItemType:  convert.StringPointer(model.Zendesk.ItemType),
+			BatchSize: convert.IntPointer(model.Zendesk.BatchSize),
+		}
+		return config, diags
+	}
+
+	return nil, diags
+}
+
+// KeepSourceUnstoredAttributes sets the connector attributes of model that the API
+// does not return to their values in prior, when both set the same block.
+func KeepSourceUnstoredAttributes(model, prior *SourceModel) {
+	if !model.Box.IsNull() && !model.Box.IsUnknown() && !prior.Box.IsNull() && !prior.Box.IsUnknown() {
+		model.Box.RemoteUrl = prior.Box.RemoteUrl
+	}
+}
@@ -5,8 +5,12 @@ package resource_source
 import (
 	"context"
 	"fmt"
+	"github.com/aws-gopher/terraform-provider-unstructured/internal/convert"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -67,6 +71,22 @@ func SourceResourceSchema(ctx context.Context) schema.Schema {
 				},
 				Optional: true,
 			},
+			"check_connection": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Check that the source can connect after it is created or updated, and wait for the check to finish",
+				MarkdownDescription: "Check that the source can connect after it is created or updated, and wait for the check to finish",
+			},
+			"check_connection_mode": schema.StringAttribute{
+				Optional:            true,
+				Description:         "What a failed connection check does: `error` fails the apply, `warn` only reports a warning. Defaults to `error`",
+				MarkdownDescription: "What a failed connection check does: `error` fails the apply, `warn` only reports a warning. Defaults to `error`",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"error",
+						"warn",
+					),
+				},
+			},
 			"confluence": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
 					"api_token": schema.StringAttribute{
@@ -74,6 +94,7 @@ func SourceResourceSchema(ctx context.Context) schema.Schema {
 					},
 					"cloud": schema.BoolAttribute{
 						Optional: true,
+						Computed: true,
 					},
 					"extract_files": schema.BoolAttribute{
 						Optional: true,
@@ -83,9 +104,11 @@ func SourceResourceSchema(ctx context.Context) schema.Schema {
 					},
 					"max_num_of_docs_from_each_space": schema.Int64Attribute{
 						Optional: true,
+						Computed: true,
 					},
 					"max_num_of_spaces": schema.Int64Attribute{
 						Optional: true,
+						Computed: true,
 					},
 					"password": schema.StringAttribute{
 						Optional: true,
@@ -148,6 +171,25 @@ func SourceResourceSchema(ctx context.Context) schema.Schema {
 			"created_at": schema.StringAttribute{
 				Computed: true,
 			},
+			"custom": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"config_json": schema.StringAttribute{
+						CustomType:          jsontypes.NormalizedType{},
+						Required:            true,
+						Description:         "The connector config as a JSON object, sent to the API as is. Formatting and key order changes do not cause a diff",
+						MarkdownDescription: "The connector config as a JSON object, sent to the API as is. Formatting and key order changes do not cause a diff",
+					},
+					"type": schema.StringAttribute{
+						Required:            true,
+						Description:         "The connector type, as the API names it",
+						MarkdownDescription: "The connector type, as the API names it",
+					},
+				},
+				CustomType:          convert.NewCustomConnectorType(),
+				Optional:            true,
+				Description:         "A connector that has no block of its own, configured as raw JSON. Read fills it in when the API returns a source of a connector type the provider does not model",
+				MarkdownDescription: "A connector that has no block of its own, configured as raw JSON. Read fills it in when the API returns a source of a connector type the provider does not model",
+			},
 			"databricks_volumes": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
 					"catalog": schema.StringAttribute{
@@ -179,6 +221,12 @@ func SourceResourceSchema(ctx context.Context) schema.Schema {
 				},
 				Optional: true,
 			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Refuse to delete the source while true. Set it to false and apply before destroying or replacing the source. Defaults to the provider's `deletion_protection`",
+				MarkdownDescription: "Refuse to delete the source while true. Set it to false and apply before destroying or replacing the source. Defaults to the provider's `deletion_protection`",
+			},
 			"dropbox": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
 					"recursive": schema.BoolAttribute{
@@ -323,9 +371,11 @@ func SourceResourceSchema(ctx context.Context) schema.Schema {
 					},
 					"num_messages_to_consume": schema.Int64Attribute{
 						Optional: true,
+						Computed: true,
 					},
 					"port": schema.Int64Attribute{
 						Optional: true,
+						Computed: true,
 					},
 					"secret": schema.StringAttribute{
 						Required: true,
@@ -605,6 +655,11 @@ func SourceResourceSchema(ctx context.Context) schema.Schema {
 				},
 				Optional: true,
 			},
+			"type": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The connector type of the source, as the API names it, such as `s3` or `kafka-cloud`",
+				MarkdownDescription: "The connector type of the source, as the API names it, such as `s3` or `kafka-cloud`",
+			},
 			"updated_at": schema.StringAttribute{
 				Computed: true,
 			},
@@ -638,30 +693,35 @@ func SourceResourceSchema(ctx context.Context) schema.Schema {
 }
 
 type SourceModel struct {
-	Azure             AzureValue             `tfsdk:"azure"`
-	Box               BoxValue               `tfsdk:"box"`
-	Confluence        ConfluenceValue        `tfsdk:"confluence"`
-	Couchbase         CouchbaseValue         `tfsdk:"couchbase"`
-	CreatedAt         types.String           `tfsdk:"created_at"`
-	DatabricksVolumes DatabricksVolumesValue `tfsdk:"databricks_volumes"`
-	Dropbox           DropboxValue           `tfsdk:"dropbox"`
-	Elasticsearch     ElasticsearchValue     `tfsdk:"elasticsearch"`
-	Gcs               GcsValue               `tfsdk:"gcs"`
-	GoogleDrive       GoogleDriveValue       `tfsdk:"google_drive"`
-	Id                types.String           `tfsdk:"id"`
-	Jira              JiraValue              `tfsdk:"jira"`
-	KafkaCloud        KafkaCloudValue        `tfsdk:"kafka_cloud"`
-	Mongodb           MongodbValue           `tfsdk:"mongodb"`
-	Name              types.String           `tfsdk:"name"`
-	Onedrive          OnedriveValue          `tfsdk:"onedrive"`
-	Outlook           OutlookValue           `tfsdk:"outlook"`
-	Postgres          PostgresValue          `tfsdk:"postgres"`
-	S3                S3Value                `tfsdk:"s3"`
-	Salesforce        SalesforceValue        `tfsdk:"salesforce"`
-	Sharepoint        SharepointValue        `tfsdk:"sharepoint"`
-	Snowflake         SnowflakeValue         `tfsdk:"snowflake"`
-	UpdatedAt         types.String           `tfsdk:"updated_at"`
-	Zendesk           ZendeskValue           `tfsdk:"zendesk"`
+	Azure               AzureValue                   `tfsdk:"azure"`
+	Box                 BoxValue                     `tfsdk:"box"`
+	CheckConnection     types.Bool                   `tfsdk:"check_connection"`
+	CheckConnectionMode types.String                 `tfsdk:"check_connection_mode"`
+	Confluence          ConfluenceValue              `tfsdk:"confluence"`
+	Couchbase           CouchbaseValue               `tfsdk:"couchbase"`
+	CreatedAt           types.String                 `tfsdk:"created_at"`
+	Custom              convert.CustomConnectorValue `tfsdk:"custom"`
+	DatabricksVolumes   DatabricksVolumesValue       `tfsdk:"databricks_volumes"`
+	DeletionProtection  types.Bool                   `tfsdk:"deletion_protection"`
+	Dropbox             DropboxValue                 `tfsdk:"dropbox"`
+	Elasticsearch       ElasticsearchValue           `tfsdk:"elasticsearch"`
+	Gcs                 GcsValue                     `tfsdk:"gcs"`
+	GoogleDrive         GoogleDriveValue             `tfsdk:"google_drive"`
+	Id                  types.String                 `tfsdk:"id"`
+	Jira                JiraValue                    `tfsdk:"jira"`
+	KafkaCloud          KafkaCloudValue              `tfsdk:"kafka_cloud"`
+	Mongodb             MongodbValue                 `tfsdk:"mongodb"`
+	Name                types.String                 `tfsdk:"name"`
+	Onedrive            OnedriveValue                `tfsdk:"onedrive"`
+	Outlook             OutlookValue                 `tfsdk:"outlook"`
+	Postgres            PostgresValue                `tfsdk:"postgres"`
+	S3                  S3Value                      `tfsdk:"s3"`
+	Salesforce          SalesforceValue              `tfsdk:"salesforce"`
+	Sharepoint          SharepointValue              `tfsdk:"sharepoint"`
+	Snowflake           SnowflakeValue               `tfsdk:"snowflake"`
+	Type                types.String                 `tfsdk:"type"`
+	UpdatedAt           types.String                 `tfsdk:"updated_at"`
+	Zendesk             ZendeskValue                 `tfsdk:"zendesk"`
 }
 
 var _ basetypes.ObjectTypable = AzureType{}
@@ -3316,6 +3376,385 @@ func (v CouchbaseValue) AttributeTypes(ctx context.Context) map[string]attr.Type
 	}
 }
 
+var _ basetypes.ObjectTypable = CustomType{}
+
+type CustomType struct {
+	basetypes.ObjectType
+}
+
+func (t CustomType) Equal(o attr.Type) bool {
+	other, ok := o.(CustomType)
+
+	if !ok {
+		return false
+	}
+
+	return t.ObjectType.Equal(other.ObjectType)
+}
+
+func (t CustomType) String() string {
+	return "CustomType"
+}
+
+func (t CustomType) ValueFromObject(ctx context.Context, in basetypes.ObjectValue) (basetypes.ObjectValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := in.Attributes()
+
+	configJsonAttribute, ok := attributes["config_json"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`config_json is missing from object`)
+
+		return nil, diags
+	}
+
+	configJsonVal, ok := configJsonAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`config_json expected to be basetypes.StringValue, was: %T`, configJsonAttribute))
+	}
+
+	typeAttribute, ok := attributes["type"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`type is missing from object`)
+
+		return nil, diags
+	}
+
+	typeVal, ok := typeAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`type expected to be basetypes.StringValue, was: %T`, typeAttribute))
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return CustomValue{
+		ConfigJson: configJsonVal,
+		CustomType: typeVal,
+		state:      attr.ValueStateKnown,
+	}, diags
+}
+
+func NewCustomValueNull() CustomValue {
+	return CustomValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+func NewCustomValueUnknown() CustomValue {
+	return CustomValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+func NewCustomValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (CustomValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	for name, attributeType := range attributeTypes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing CustomValue Attribute Value",
+				"While creating a CustomValue value, a missing attribute value was detected. "+
+					"A CustomValue must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("CustomValue Attribute Name (%s) Expected Type: %s", name, attributeType.String()),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid CustomValue Attribute Type",
+				"While creating a CustomValue value, an invalid attribute value was detected. "+
+					"A CustomValue must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("CustomValue Attribute Name (%s) Expected Type: %s\n", name, attributeType.String())+
+					fmt.Sprintf("CustomValue Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	for name := range attributes {
+		_, ok := attributeTypes[name]
+
+		if !ok {
+			diags.AddError(
+				"Extra CustomValue Attribute Value",
+				"While creating a CustomValue value, an extra attribute value was detected. "+
+					"A CustomValue must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra CustomValue Attribute Name: %s", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewCustomValueUnknown(), diags
+	}
+
+	configJsonAttribute, ok := attributes["config_json"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`config_json is missing from object`)
+
+		return NewCustomValueUnknown(), diags
+	}
+
+	configJsonVal, ok := configJsonAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`config_json expected to be basetypes.StringValue, was: %T`, configJsonAttribute))
+	}
+
+	typeAttribute, ok := attributes["type"]
+
+	if !ok {
+		diags.AddError(
+			"Attribute Missing",
+			`type is missing from object`)
+
+		return NewCustomValueUnknown(), diags
+	}
+
+	typeVal, ok := typeAttribute.(basetypes.StringValue)
+
+	if !ok {
+		diags.AddError(
+			"Attribute Wrong Type",
+			fmt.Sprintf(`type expected to be basetypes.StringValue, was: %T`, typeAttribute))
+	}
+
+	if diags.HasError() {
+		return NewCustomValueUnknown(), diags
+	}
+
+	return CustomValue{
+		ConfigJson: configJsonVal,
+		CustomType: typeVal,
+		state:      attr.ValueStateKnown,
+	}, diags
+}
+
+func NewCustomValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) CustomValue {
+	object, diags := NewCustomValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewCustomValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return object
+}
+
+func (t CustomType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewCustomValueNull(), nil
+	}
+
+	if !in.Type().Equal(t.TerraformType(ctx)) {
+		return nil, fmt.Errorf("expected %s, got %s", t.TerraformType(ctx), in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NewCustomValueUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NewCustomValueNull(), nil
+	}
+
+	attributes := map[string]attr.Value{}
+
+	val := map[string]tftypes.Value{}
+
+	err := in.As(&val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range val {
+		a, err := t.AttrTypes[k].ValueFromTerraform(ctx, v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[k] = a
+	}
+
+	return NewCustomValueMust(CustomValue{}.AttributeTypes(ctx), attributes), nil
+}
+
+func (t CustomType) ValueType(ctx context.Context) attr.Value {
+	return CustomValue{}
+}
+
+var _ basetypes.ObjectValuable = CustomValue{}
+
+type CustomValue struct {
+	ConfigJson basetypes.StringValue `tfsdk:"config_json"`
+	CustomType basetypes.StringValue `tfsdk:"type"`
+	state      attr.ValueState
+}
+
+func (v CustomValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	attrTypes := make(map[string]tftypes.Type, 2)
+
+	var val tftypes.Value
+	var err error
+
+	attrTypes["config_json"] = basetypes.StringType{}.TerraformType(ctx)
+	attrTypes["type"] = basetypes.StringType{}.TerraformType(ctx)
+
+	objectType := tftypes.Object{AttributeTypes: attrTypes}
+
+	switch v.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, 2)
+
+		val, err = v.ConfigJson.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["config_json"] = val
+
+		val, err = v.CustomType.ToTerraformValue(ctx)
+
+		if err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		vals["type"] = val
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
+			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", v.state))
+	}
+}
+
+func (v CustomValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+func (v CustomValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+func (v CustomValue) String() string {
+	return "CustomValue"
+}
+
+func (v CustomValue) ToObjectValue(ctx context.Context) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributeTypes := map[string]attr.Type{
+		"config_json": basetypes.StringType{},
+		"type":        basetypes.StringType{},
+	}
+
+	if v.IsNull() {
+		return types.ObjectNull(attributeTypes), diags
+	}
+
+	if v.IsUnknown() {
+		return types.ObjectUnknown(attributeTypes), diags
+	}
+
+	objVal, diags := types.ObjectValue(
+		attributeTypes,
+		map[string]attr.Value{
+			"config_json": v.ConfigJson,
+			"type":        v.CustomType,
+		})
+
+	return objVal, diags
+}
+
+func (v CustomValue) Equal(o attr.Value) bool {
+	other, ok := o.(CustomValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != other.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if !v.ConfigJson.Equal(other.ConfigJson) {
+		return false
+	}
+
+	if !v.CustomType.Equal(other.CustomType) {
+		return false
+	}
+
+	return true
+}
+
+func (v CustomValue) Type(ctx context.Context) attr.Type {
+	return CustomType{
+		basetypes.ObjectType{
+			AttrTypes: v.AttributeTypes(ctx),
+		},
+	}
+}
+
+func (v CustomValue) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"config_json": basetypes.StringType{},
+		"type":        basetypes.StringType{},
+	}
+}
+
 var _ basetypes.ObjectTypable = DatabricksVolumesType{}
 
 type DatabricksVolumesType struct {
@@ -23,6 +23,12 @@ func WorkflowResourceSchema(ctx context.Context) schema.Schema {
 			"created_at": schema.StringAttribute{
 				Computed: true,
 			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Refuse to delete the workflow while true. Set it to false and apply before destroying or replacing the workflow. Defaults to the provider's `deletion_protection`",
+				MarkdownDescription: "Refuse to delete the workflow while true. Set it to false and apply before destroying or replacing the workflow. Defaults to the provider's `deletion_protection`",
+			},
 			"destination_id": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
@@ -126,19 +132,20 @@ func WorkflowResourceSchema(ctx context.Context) schema.Schema {
 }
 
 type WorkflowModel struct {
-	CreatedAt     types.String `tfsdk:"created_at"`
-	DestinationId types.String `tfsdk:"destination_id"`
-	Destinations  types.List   `tfsdk:"destinations"`
-	Id            types.String `tfsdk:"id"`
-	Name          types.String `tfsdk:"name"`
-	ReprocessAll  types.Bool   `tfsdk:"reprocess_all"`
-	Schedule      types.String `tfsdk:"schedule"`
-	SourceId      types.String `tfsdk:"source_id"`
-	Sources       types.List   `tfsdk:"sources"`
-	Status        types.String `tfsdk:"status"`
-	UpdatedAt     types.String `tfsdk:"updated_at"`
-	WorkflowNodes types.List   `tfsdk:"workflow_nodes"`
-	WorkflowType  types.String `tfsdk:"workflow_type"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+	DestinationId      types.String `tfsdk:"destination_id"`
+	Destinations       types.List   `tfsdk:"destinations"`
+	Id                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	ReprocessAll       types.Bool   `tfsdk:"reprocess_all"`
+	Schedule           types.String `tfsdk:"schedule"`
+	SourceId           types.String `tfsdk:"source_id"`
+	Sources            types.List   `tfsdk:"sources"`
+	Status             types.String `tfsdk:"status"`
+	UpdatedAt          types.String `tfsdk:"updated_at"`
+	WorkflowNodes      types.List   `tfsdk:"workflow_nodes"`
+	WorkflowType       types.String `tfsdk:"workflow_type"`
 }
 
 var _ basetypes.ObjectTypable = WorkflowNodesType{}
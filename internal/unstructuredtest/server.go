@@ -0,0 +1,557 @@
+// Package unstructuredtest provides an in-memory fake of the Unstructured API
+// for tests that should not depend on a live account.
+//
+// The fake implements the workflows, sources, destinations, connection check
+// and jobs endpoints the provider uses. It is stateful: objects created
+// through it can be read, listed, updated and deleted until the server is
+// closed.
+package unstructuredtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-uuid"
+)
+
+// APIKey is the only API key the fake accepts.
+const APIKey = "unstructuredtest-api-key"
+
+// object is an API object as it is serialized in responses.
+type object = map[string]any
+
+// collection is an insertion-ordered set of API objects keyed by ID.
+type collection struct {
+	kind  string
+	items map[string]object
+	order []string
+
+	// checks holds the latest connection check of each connector by ID.
+	checks map[string]object
+}
+
+func newCollection(kind string) *collection {
+	return &collection{kind: kind, items: map[string]object{}, checks: map[string]object{}}
+}
+
+func (c *collection) get(id string) (object, bool) {
+	o, ok := c.items[id]
+	return o, ok
+}
+
+func (c *collection) put(o object) {
+	id, _ := o["id"].(string)
+	if _, ok := c.items[id]; !ok {
+		c.order = append(c.order, id)
+	}
+
+	c.items[id] = o
+}
+
+func (c *collection) delete(id string) bool {
+	if _, ok := c.items[id]; !ok {
+		return false
+	}
+
+	delete(c.items, id)
+	delete(c.checks, id)
+	c.order = slices.DeleteFunc(c.order, func(v string) bool { return v == id })
+
+	return true
+}
+
+// list returns the objects matching keep, in creation order.
+func (c *collection) list(keep func(object) bool) []object {
+	out := make([]object, 0, len(c.order))
+	for _, id := range c.order {
+		if o := c.items[id]; keep(o) {
+			out = append(out, o)
+		}
+	}
+
+	return out
+}
+
+// Server is a fake Unstructured API backed by an httptest.Server.
+type Server struct {
+	*httptest.Server
+
+	mu           sync.Mutex
+	sources      *collection
+	destinations *collection
+	workflows    *collection
+	jobs         *collection
+}
+
+// NewServer starts a fake Unstructured API. The caller must call Close when
+// finished.
+func NewServer() *Server {
+	s := &Server{
+		sources:      newCollection("Source"),
+		destinations: newCollection("Destination"),
+		workflows:    newCollection("Workflow"),
+		jobs:         newCollection("Job"),
+	}
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /api/v1/sources", s.listConnectors(s.sources, "source_type"))
+	mux.HandleFunc("GET /api/v1/sources/{$}", s.listConnectors(s.sources, "source_type"))
+	mux.HandleFunc("POST /api/v1/sources/{$}", s.createConnector(s.sources))
+	mux.HandleFunc("GET /api/v1/sources/{id}", s.getObject(s.sources))
+	mux.HandleFunc("PUT /api/v1/sources/{id}", s.updateConnector(s.sources))
+	mux.HandleFunc("DELETE /api/v1/sources/{id}", s.deleteObject(s.sources))
+	mux.HandleFunc("POST /api/v1/sources/{id}/connection-check", s.createConnectionCheck(s.sources))
+	mux.HandleFunc("GET /api/v1/sources/{id}/connection-check", s.getConnectionCheck(s.sources))
+
+	mux.HandleFunc("GET /api/v1/destinations", s.listConnectors(s.destinations, "destination_type"))
+	mux.HandleFunc("GET /api/v1/destinations/{$}", s.listConnectors(s.destinations, "destination_type"))
+	mux.HandleFunc("POST /api/v1/destinations/{$}", s.createConnector(s.destinations))
+	mux.HandleFunc("GET /api/v1/destinations/{id}", s.getObject(s.destinations))
+	mux.HandleFunc("PUT /api/v1/destinations/{id}", s.updateConnector(s.destinations))
+	mux.HandleFunc("DELETE /api/v1/destinations/{id}", s.deleteObject(s.destinations))
+	mux.HandleFunc("POST /api/v1/destinations/{id}/connection-check", s.createConnectionCheck(s.destinations))
+	mux.HandleFunc("GET /api/v1/destinations/{id}/connection-check", s.getConnectionCheck(s.destinations))
+
+	mux.HandleFunc("GET /api/v1/workflows", s.listWorkflows)
+	mux.HandleFunc("GET /api/v1/workflows/{$}", s.listWorkflows)
+	mux.HandleFunc("POST /api/v1/workflows/{$}", s.createWorkflow)
+	mux.HandleFunc("GET /api/v1/workflows/{id}", s.getObject(s.workflows))
+	mux.HandleFunc("PUT /api/v1/workflows/{id}", s.updateWorkflow)
+	mux.HandleFunc("DELETE /api/v1/workflows/{id}", s.deleteObject(s.workflows))
+	mux.HandleFunc("POST /api/v1/workflows/{id}/run", s.runWorkflow)
+
+	mux.HandleFunc("GET /api/v1/jobs/{$}", s.listJobs)
+	mux.HandleFunc("GET /api/v1/jobs/{id}", s.getObject(s.jobs))
+	mux.HandleFunc("POST /api/v1/jobs/{id}/cancel", s.cancelJob)
+
+	s.Server = httptest.NewServer(s.authenticate(mux))
+
+	return s
+}
+
+// Endpoint returns the base URL to configure the provider or SDK with.
+func (s *Server) Endpoint() string {
+	return s.URL + "/api/v1"
+}
+
+// authenticate rejects requests that don't carry APIKey.
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Unstructured-API-Key") != APIKey {
+			writeDetail(w, http.StatusUnauthorized, "API key is missing or invalid")
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) listConnectors(c *collection, typeParam string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		typ := r.URL.Query().Get(typeParam)
+
+		writeJSON(w, http.StatusOK, c.list(func(o object) bool {
+			return typ == "" || o["type"] == typ
+		}))
+	}
+}
+
+func (s *Server) createConnector(c *collection) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var in struct {
+			Name   string          `json:"name"`
+			Type   string          `json:"type"`
+			Config json.RawMessage `json:"config"`
+		}
+
+		if !decode(w, r, &in) {
+			return
+		}
+
+		switch {
+		case in.Name == "":
+			writeValidationError(w, "name", "Field required")
+			return
+		case in.Type == "":
+			writeValidationError(w, "type", "Field required")
+			return
+		case len(in.Config) == 0:
+			writeValidationError(w, "config", "Field required")
+			return
+		}
+
+		now := timestamp()
+		o := object{
+			"id":         newID(),
+			"name":       in.Name,
+			"type":       in.Type,
+			"config":     in.Config,
+			"created_at": now,
+			"updated_at": now,
+		}
+
+		c.put(o)
+		writeJSON(w, http.StatusOK, o)
+	}
+}
+
+func (s *Server) updateConnector(c *collection) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		o, ok := c.get(r.PathValue("id"))
+		if !ok {
+			writeNotFound(w, c.kind)
+			return
+		}
+
+		var in struct {
+			Config json.RawMessage `json:"config"`
+		}
+
+		if !decode(w, r, &in) {
+			return
+		}
+
+		if len(in.Config) == 0 {
+			writeValidationError(w, "config", "Field required")
+			return
+		}
+
+		o["config"] = in.Config
+		o["updated_at"] = timestamp()
+
+		writeJSON(w, http.StatusOK, o)
+	}
+}
+
+func (s *Server) getObject(c *collection) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		o, ok := c.get(r.PathValue("id"))
+		if !ok {
+			writeNotFound(w, c.kind)
+			return
+		}
+
+		writeJSON(w, http.StatusOK, o)
+	}
+}
+
+func (s *Server) deleteObject(c *collection) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !c.delete(r.PathValue("id")) {
+			writeNotFound(w, c.kind)
+			return
+		}
+
+		writeDetail(w, http.StatusOK, c.kind+" deleted")
+	}
+}
+
+// createConnectionCheck schedules a connection check of a connector. The fake
+// cannot reach the connector, so every check succeeds once it is read.
+func (s *Server) createConnectionCheck(c *collection) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		if _, ok := c.get(id); !ok {
+			writeNotFound(w, c.kind)
+			return
+		}
+
+		check := object{
+			"id":         newID(),
+			"status":     "SCHEDULED",
+			"created_at": timestamp(),
+		}
+
+		c.checks[id] = check
+		writeJSON(w, http.StatusOK, check)
+	}
+}
+
+func (s *Server) getConnectionCheck(c *collection) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		check, ok := c.checks[r.PathValue("id")]
+		if !ok {
+			writeNotFound(w, "Connection check")
+			return
+		}
+
+		if check["status"] == "SCHEDULED" {
+			check["status"] = "SUCCESS"
+			check["reported_at"] = timestamp()
+		}
+
+		writeJSON(w, http.StatusOK, check)
+	}
+}
+
+// workflowInput is the body of the create and update workflow requests.
+type workflowInput struct {
+	Name          *string          `json:"name"`
+	SourceID      *string          `json:"source_id"`
+	DestinationID *string          `json:"destination_id"`
+	WorkflowType  *string          `json:"workflow_type"`
+	WorkflowNodes []map[string]any `json:"workflow_nodes"`
+	Schedule      *string          `json:"schedule"`
+	ReprocessAll  *bool            `json:"reprocess_all"`
+}
+
+// apply copies the fields set in the input onto the workflow object. It
+// reports false and writes an error response if a referenced connector does
+// not exist.
+func (s *Server) apply(w http.ResponseWriter, o object, in workflowInput) bool {
+	if in.SourceID != nil {
+		if _, ok := s.sources.get(*in.SourceID); !ok {
+			writeNotFound(w, s.sources.kind)
+			return false
+		}
+
+		o["sources"] = []string{*in.SourceID}
+	}
+
+	if in.DestinationID != nil {
+		if _, ok := s.destinations.get(*in.DestinationID); !ok {
+			writeNotFound(w, s.destinations.kind)
+			return false
+		}
+
+		o["destinations"] = []string{*in.DestinationID}
+	}
+
+	if in.Name != nil {
+		o["name"] = *in.Name
+	}
+
+	if in.WorkflowType != nil {
+		o["workflow_type"] = *in.WorkflowType
+	}
+
+	if in.WorkflowNodes != nil {
+		for _, node := range in.WorkflowNodes {
+			if id, _ := node["id"].(string); id == "" {
+				node["id"] = newID()
+			}
+		}
+
+		o["workflow_nodes"] = in.WorkflowNodes
+	}
+
+	if in.Schedule != nil {
+		o["schedule"] = object{
+			"crontab_entries": []object{{"cron_expression": *in.Schedule}},
+		}
+	}
+
+	if in.ReprocessAll != nil {
+		o["reprocess_all"] = *in.ReprocessAll
+	}
+
+	return true
+}
+
+func (s *Server) createWorkflow(w http.ResponseWriter, r *http.Request) {
+	var in workflowInput
+	if !decode(w, r, &in) {
+		return
+	}
+
+	if in.Name == nil || *in.Name == "" {
+		writeValidationError(w, "name", "Field required")
+		return
+	}
+
+	now := timestamp()
+	o := object{
+		"id":             newID(),
+		"sources":        []string{},
+		"destinations":   []string{},
+		"workflow_nodes": []object{},
+		"status":         "active",
+		"created_at":     now,
+		"updated_at":     now,
+	}
+
+	if !s.apply(w, o, in) {
+		return
+	}
+
+	s.workflows.put(o)
+	writeJSON(w, http.StatusOK, o)
+}
+
+func (s *Server) updateWorkflow(w http.ResponseWriter, r *http.Request) {
+	o, ok := s.workflows.get(r.PathValue("id"))
+	if !ok {
+		writeNotFound(w, s.workflows.kind)
+		return
+	}
+
+	var in workflowInput
+	if !decode(w, r, &in) {
+		return
+	}
+
+	// Work on a copy so a failed update leaves the workflow untouched.
+	updated := make(object, len(o))
+	for k, v := range o {
+		updated[k] = v
+	}
+
+	if !s.apply(w, updated, in) {
+		return
+	}
+
+	updated["updated_at"] = timestamp()
+	s.workflows.put(updated)
+
+	writeJSON(w, http.StatusOK, updated)
+}
+
+func (s *Server) listWorkflows(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	contains := func(o object, key, id string) bool {
+		ids, _ := o[key].([]string)
+		return slices.Contains(ids, id)
+	}
+
+	workflows := s.workflows.list(func(o object) bool {
+		name, _ := o["name"].(string)
+
+		switch {
+		case q.Has("source_id") && !contains(o, "sources", q.Get("source_id")):
+			return false
+		case q.Has("destination_id") && !contains(o, "destinations", q.Get("destination_id")):
+			return false
+		case q.Has("status") && o["status"] != q.Get("status"):
+			return false
+		case q.Has("name") && !strings.Contains(name, q.Get("name")):
+			return false
+		}
+
+		return true
+	})
+
+	writeJSON(w, http.StatusOK, paginate(workflows, q.Get("page"), q.Get("page_size")))
+}
+
+func (s *Server) runWorkflow(w http.ResponseWriter, r *http.Request) {
+	wf, ok := s.workflows.get(r.PathValue("id"))
+	if !ok {
+		writeNotFound(w, s.workflows.kind)
+		return
+	}
+
+	job := object{
+		"id":            newID(),
+		"workflow_id":   wf["id"],
+		"workflow_name": wf["name"],
+		"status":        "SCHEDULED",
+		"created_at":    time.Now().UTC().Format("2006-01-02T15:04:05"),
+		"job_type":      "ephemeral",
+	}
+
+	s.jobs.put(job)
+	writeJSON(w, http.StatusOK, job)
+}
+
+func (s *Server) listJobs(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	writeJSON(w, http.StatusOK, s.jobs.list(func(o object) bool {
+		switch {
+		case q.Has("workflow_id") && o["workflow_id"] != q.Get("workflow_id"):
+			return false
+		case q.Has("status") && o["status"] != q.Get("status"):
+			return false
+		}
+
+		return true
+	}))
+}
+
+func (s *Server) cancelJob(w http.ResponseWriter, r *http.Request) {
+	job, ok := s.jobs.get(r.PathValue("id"))
+	if !ok {
+		writeNotFound(w, s.jobs.kind)
+		return
+	}
+
+	job["status"] = "STOPPED"
+	writeJSON(w, http.StatusOK, job)
+}
+
+// paginate returns the requested 1-based page of objects. Without a page
+// size, all objects are returned.
+func paginate(objects []object, page, pageSize string) []object {
+	size, err := strconv.Atoi(pageSize)
+	if err != nil || size <= 0 {
+		return objects
+	}
+
+	n, err := strconv.Atoi(page)
+	if err != nil || n <= 0 {
+		n = 1
+	}
+
+	start := min((n-1)*size, len(objects))
+	end := min(start+size, len(objects))
+
+	return objects[start:end]
+}
+
+func newID() string {
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		panic(fmt.Sprintf("failed to generate UUID: %v", err))
+	}
+
+	return id
+}
+
+func timestamp() string {
+	return time.Now().UTC().Truncate(time.Second).Format(time.RFC3339)
+}
+
+func decode(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeDetail(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return false
+	}
+
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeDetail(w http.ResponseWriter, code int, detail string) {
+	writeJSON(w, code, object{"detail": detail})
+}
+
+func writeNotFound(w http.ResponseWriter, kind string) {
+	writeDetail(w, http.StatusNotFound, kind+" not found")
+}
+
+func writeValidationError(w http.ResponseWriter, field, msg string) {
+	writeJSON(w, http.StatusUnprocessableEntity, object{
+		"detail": []object{{
+			"loc":  []string{"body", field},
+			"msg":  msg,
+			"type": "missing",
+		}},
+	})
+}
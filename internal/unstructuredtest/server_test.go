@@ -0,0 +1,231 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package unstructuredtest
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/aws-gopher/unstructured-sdk-go"
+)
+
+func newTestClient(t *testing.T, srv *Server, key string) *unstructured.Client {
+	t.Helper()
+
+	client, err := unstructured.New(
+		unstructured.WithClient(&http.Client{}),
+		unstructured.WithEndpoint(srv.Endpoint()),
+		unstructured.WithKey(key),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client
+}
+
+func wantStatus(t *testing.T, err error, code int) {
+	t.Helper()
+
+	var apiErr *unstructured.APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != code {
+		t.Fatalf("expected API error %d, got %v", code, err)
+	}
+}
+
+func TestServerAuthentication(t *testing.T) {
+	srv := NewServer()
+	t.Cleanup(srv.Close)
+
+	_, err := newTestClient(t, srv, "wrong-key").ListSources(t.Context(), "")
+	wantStatus(t, err, http.StatusUnauthorized)
+}
+
+func TestServerSources(t *testing.T) {
+	srv := NewServer()
+	t.Cleanup(srv.Close)
+
+	client := newTestClient(t, srv, APIKey)
+	ctx := t.Context()
+
+	anonymous := true
+	created, err := client.CreateSource(ctx, unstructured.CreateSourceRequest{
+		Name:   "test-source",
+		Config: &unstructured.S3SourceConnectorConfigInput{RemoteURL: "s3://bucket/", Anonymous: &anonymous},
+	})
+	if err != nil {
+		t.Fatalf("CreateSource() error = %v", err)
+	}
+
+	got, err := client.GetSource(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("GetSource() error = %v", err)
+	}
+
+	config, ok := got.Config.(*unstructured.S3SourceConnectorConfig)
+	if !ok {
+		t.Fatalf("GetSource() config = %T, want *unstructured.S3SourceConnectorConfig", got.Config)
+	}
+
+	if got.Name != "test-source" || config.RemoteURL != "s3://bucket/" || !config.Anonymous {
+		t.Errorf("GetSource() = %+v, config %+v", got, config)
+	}
+
+	_, err = client.UpdateSource(ctx, unstructured.UpdateSourceRequest{
+		ID:     created.ID,
+		Config: &unstructured.S3SourceConnectorConfigInput{RemoteURL: "s3://other-bucket/"},
+	})
+	if err != nil {
+		t.Fatalf("UpdateSource() error = %v", err)
+	}
+
+	if got, err = client.GetSource(ctx, created.ID); err != nil {
+		t.Fatalf("GetSource() error = %v", err)
+	} else if config, _ := got.Config.(*unstructured.S3SourceConnectorConfig); config == nil || config.RemoteURL != "s3://other-bucket/" {
+		t.Errorf("GetSource() config after update = %+v", got.Config)
+	}
+
+	sources, err := client.ListSources(ctx, unstructured.ConnectorTypeS3)
+	if err != nil || len(sources) != 1 {
+		t.Errorf("ListSources(s3) = %d sources, error %v, want 1", len(sources), err)
+	}
+
+	sources, err = client.ListSources(ctx, unstructured.ConnectorTypeGCS)
+	if err != nil || len(sources) != 0 {
+		t.Errorf("ListSources(gcs) = %d sources, error %v, want 0", len(sources), err)
+	}
+
+	check, err := client.CreateSourceConnectionCheck(ctx, created.ID)
+	if err != nil || check.Status != unstructured.ConnectionCheckStatusScheduled {
+		t.Fatalf("CreateSourceConnectionCheck() = %+v, error %v, want scheduled", check, err)
+	}
+
+	check, err = client.GetSourceConnectionCheck(ctx, created.ID)
+	if err != nil || check.Status != unstructured.ConnectionCheckStatusSuccess {
+		t.Errorf("GetSourceConnectionCheck() = %+v, error %v, want success", check, err)
+	}
+
+	if err := client.DeleteSource(ctx, created.ID); err != nil {
+		t.Fatalf("DeleteSource() error = %v", err)
+	}
+
+	_, err = client.GetSource(ctx, created.ID)
+	wantStatus(t, err, http.StatusNotFound)
+}
+
+func TestServerDestinations(t *testing.T) {
+	srv := NewServer()
+	t.Cleanup(srv.Close)
+
+	client := newTestClient(t, srv, APIKey)
+	ctx := t.Context()
+
+	created, err := client.CreateDestination(ctx, unstructured.CreateDestinationRequest{
+		Name:   "test-destination",
+		Config: &unstructured.S3DestinationConnectorConfigInput{RemoteURL: "s3://bucket/output/"},
+	})
+	if err != nil {
+		t.Fatalf("CreateDestination() error = %v", err)
+	}
+
+	got, err := client.GetDestination(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("GetDestination() error = %v", err)
+	}
+
+	if got.Name != "test-destination" || got.Type != unstructured.ConnectorTypeS3 {
+		t.Errorf("GetDestination() = %+v", got)
+	}
+
+	if _, err := client.CreateDestinationConnectionCheck(ctx, created.ID); err != nil {
+		t.Fatalf("CreateDestinationConnectionCheck() error = %v", err)
+	}
+
+	check, err := client.GetDestinationConnectionCheck(ctx, created.ID)
+	if err != nil || check.Status != unstructured.ConnectionCheckStatusSuccess {
+		t.Errorf("GetDestinationConnectionCheck() = %+v, error %v, want success", check, err)
+	}
+
+	if err := client.DeleteDestination(ctx, created.ID); err != nil {
+		t.Fatalf("DeleteDestination() error = %v", err)
+	}
+
+	err = client.DeleteDestination(ctx, created.ID)
+	wantStatus(t, err, http.StatusNotFound)
+}
+
+func TestServerWorkflowsAndJobs(t *testing.T) {
+	srv := NewServer()
+	t.Cleanup(srv.Close)
+
+	client := newTestClient(t, srv, APIKey)
+	ctx := t.Context()
+
+	source, err := client.CreateSource(ctx, unstructured.CreateSourceRequest{
+		Name:   "test-source",
+		Config: &unstructured.S3SourceConnectorConfigInput{RemoteURL: "s3://bucket/"},
+	})
+	if err != nil {
+		t.Fatalf("CreateSource() error = %v", err)
+	}
+
+	missing := "00000000-0000-0000-0000-000000000000"
+	_, err = client.CreateWorkflow(ctx, &unstructured.CreateWorkflowRequest{
+		Name:         "test-workflow",
+		SourceID:     &missing,
+		WorkflowType: unstructured.WorkflowTypeBasic,
+	})
+	wantStatus(t, err, http.StatusNotFound)
+
+	schedule := "0 0 * * *"
+	workflow, err := client.CreateWorkflow(ctx, &unstructured.CreateWorkflowRequest{
+		Name:         "test-workflow",
+		SourceID:     &source.ID,
+		WorkflowType: unstructured.WorkflowTypeBasic,
+		Schedule:     &schedule,
+	})
+	if err != nil {
+		t.Fatalf("CreateWorkflow() error = %v", err)
+	}
+
+	if len(workflow.Sources) != 1 || workflow.Sources[0] != source.ID {
+		t.Errorf("CreateWorkflow() sources = %v, want [%s]", workflow.Sources, source.ID)
+	}
+
+	if workflow.Schedule == nil || len(workflow.Schedule.CronTabEntries) != 1 || workflow.Schedule.CronTabEntries[0].CronExpression != schedule {
+		t.Errorf("CreateWorkflow() schedule = %+v, want %q", workflow.Schedule, schedule)
+	}
+
+	name := "renamed-workflow"
+	if _, err := client.UpdateWorkflow(ctx, unstructured.UpdateWorkflowRequest{ID: workflow.ID, Name: &name}); err != nil {
+		t.Fatalf("UpdateWorkflow() error = %v", err)
+	}
+
+	workflows, err := client.ListWorkflows(ctx, &unstructured.ListWorkflowsRequest{SourceID: &source.ID})
+	if err != nil || len(workflows) != 1 || workflows[0].Name != name {
+		t.Errorf("ListWorkflows() = %+v, error %v", workflows, err)
+	}
+
+	job, err := client.RunWorkflow(ctx, &unstructured.RunWorkflowRequest{ID: workflow.ID})
+	if err != nil {
+		t.Fatalf("RunWorkflow() error = %v", err)
+	}
+
+	if err := client.CancelJob(ctx, job.ID); err != nil {
+		t.Fatalf("CancelJob() error = %v", err)
+	}
+
+	job, err = client.GetJob(ctx, job.ID)
+	if err != nil || job.Status != unstructured.JobStatusStopped || job.WorkflowID != workflow.ID {
+		t.Errorf("GetJob() = %+v, error %v", job, err)
+	}
+
+	if err := client.DeleteWorkflow(ctx, workflow.ID); err != nil {
+		t.Fatalf("DeleteWorkflow() error = %v", err)
+	}
+
+	_, err = client.GetWorkflow(ctx, workflow.ID)
+	wantStatus(t, err, http.StatusNotFound)
+}
@@ -6,7 +6,10 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
 	"log"
+	"os"
+	"path/filepath"
 
 	"github.com/aws-gopher/terraform-provider-unstructured/internal/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
@@ -22,6 +25,11 @@ var (
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		export(os.Args[2:])
+		return
+	}
+
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
@@ -41,3 +49,25 @@ func main() {
 		log.Fatal(err.Error())
 	}
 }
+
+// export runs the export subcommand, which writes the sources, destinations
+// and workflows of an account to Terraform configuration.
+func export(args []string) {
+	var cfg provider.ExportConfig
+
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	fs.StringVar(&cfg.Endpoint, "endpoint", "", "the API endpoint, defaulting to UNSTRUCTURED_API_URL, the profile or the public API")
+	fs.StringVar(&cfg.Profile, "profile", "", "the profile of the shared credentials file to read the API key from")
+	fs.StringVar(&cfg.CredentialsFile, "credentials-file", "", "the shared credentials file")
+	fs.StringVar(&cfg.Dir, "out", ".", "the directory to write the configuration to")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: %s export [flags]\n\n", filepath.Base(os.Args[0]))
+		fmt.Fprintf(fs.Output(), "Writes the sources, destinations and workflows of an account as Terraform\nconfiguration with import blocks. The API key is read from UNSTRUCTURED_API_KEY\nor the shared credentials file.\n\n")
+		fs.PrintDefaults()
+	}
+	_ = fs.Parse(args)
+
+	if err := provider.Export(context.Background(), version, cfg, os.Stderr); err != nil {
+		log.Fatal(err.Error())
+	}
+}